@@ -129,6 +129,104 @@ func (s *AccountsService) DeleteActive(ctx context.Context, accountID string) er
 	return nil
 }
 
+// AccountInput entity contains information for the creation of a new account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#account-input
+type AccountInput struct {
+	Username     *string  `json:"username,omitempty"`
+	Name         *string  `json:"name,omitempty"`
+	DisplayName  *string  `json:"display_name,omitempty"`
+	Email        *string  `json:"email,omitempty"`
+	SSHKey       *string  `json:"ssh_key,omitempty"`
+	HTTPPassword *string  `json:"http_password,omitempty"`
+	Groups       []string `json:"groups,omitempty"`
+}
+
+// CreateAccount creates a new account.
+// The username is used as account identifier and must match input.Username if that is set.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#create-account
+func (s *AccountsService) CreateAccount(ctx context.Context, username string, input *AccountInput) (*AccountInfo, error) {
+	u := fmt.Sprintf("accounts/%s", username)
+	if input == nil {
+		input = new(AccountInput)
+	}
+
+	var reply AccountInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// AccountDetailInfo entity contains detailed information about an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#account-detail-info
+type AccountDetailInfo struct {
+	AccountInfo
+	RegisteredOn Timestamp `json:"registered_on"`
+}
+
+// GetAccountDetail retrieves the details of an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-detail
+func (s *AccountsService) GetAccountDetail(ctx context.Context, account string) (*AccountDetailInfo, error) {
+	u := fmt.Sprintf("accounts/%s/detail", account)
+
+	var reply AccountDetailInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// SetName sets the full name of an account and returns the new name.
+// Some realms may not allow to modify the account name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-account-name
+func (s *AccountsService) SetName(ctx context.Context, account, name string) (string, error) {
+	u := fmt.Sprintf("accounts/%s/name", account)
+	return s.client.putString(ctx, u, map[string]string{"name": name})
+}
+
+// DeleteName deletes the name of an account.
+// Some realms may not allow to delete the account name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-account-name
+func (s *AccountsService) DeleteName(ctx context.Context, account string) error {
+	u := fmt.Sprintf("accounts/%s/name", account)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetUsername sets the username of an account and returns the new username.
+// Once set, the username cannot be changed or deleted.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-username
+func (s *AccountsService) SetUsername(ctx context.Context, account, username string) (string, error) {
+	u := fmt.Sprintf("accounts/%s/username", account)
+	return s.client.putString(ctx, u, map[string]string{"username": username})
+}
+
+// SetDisplayName sets the display name of an account and returns the new display name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-display-name
+func (s *AccountsService) SetDisplayName(ctx context.Context, account, displayName string) (string, error) {
+	u := fmt.Sprintf("accounts/%s/displayname", account)
+	return s.client.putString(ctx, u, map[string]string{"display_name": displayName})
+}
+
+// SetStatus sets the status of an account and returns the new status.
+// An empty status deletes it.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-account-status
+func (s *AccountsService) SetStatus(ctx context.Context, account, status string) (string, error) {
+	u := fmt.Sprintf("accounts/%s/status", account)
+	return s.client.putString(ctx, u, map[string]string{"status": status})
+}
+
 // SSHKeyInfo entity contains information about an SSH key of a user.
 type SSHKeyInfo struct {
 	Seq          int    `json:"seq"`
@@ -139,34 +237,82 @@ type SSHKeyInfo struct {
 	Valid        bool   `json:"valid"`
 }
 
-// ListSSHKeys Returns the SSH keys of an account.
+// ListSSHKeys Returns the SSH keys of self user.
 //
 // Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-ssh-keys
 func (s *AccountsService) ListSSHKeys(ctx context.Context) ([]*SSHKeyInfo, error) {
+	return s.ListAccountSSHKeys(ctx, "self")
+}
+
+// ListAccountSSHKeys Returns the SSH keys of an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-ssh-keys
+func (s *AccountsService) ListAccountSSHKeys(ctx context.Context, account string) ([]*SSHKeyInfo, error) {
+	u := fmt.Sprintf("accounts/%s/sshkeys", account)
 	var reply []*SSHKeyInfo
-	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "accounts/self/sshkeys", nil, &reply); err != nil {
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
 		return nil, err
 	}
 	return reply, nil
 }
 
+// GetSSHKey Retrieves an SSH key of an account by its sequence number.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-ssh-key
+func (s *AccountsService) GetSSHKey(ctx context.Context, account string, seq int) (*SSHKeyInfo, error) {
+	u := fmt.Sprintf("accounts/%s/sshkeys/%d", account, seq)
+	var reply SSHKeyInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
 // AddSSHKey Adds an SSH key for self user.
 //
-// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-ssh-keys
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#add-ssh-key
 func (s *AccountsService) AddSSHKey(ctx context.Context, key string) (*SSHKeyInfo, error) {
+	return s.AddAccountSSHKey(ctx, "self", key)
+}
+
+// AddAccountSSHKey Adds an SSH key for an account.
+// The key is uploaded as is, use AddValidatedSSHKey to check it locally first.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#add-ssh-key
+func (s *AccountsService) AddAccountSSHKey(ctx context.Context, account, key string) (*SSHKeyInfo, error) {
+	u := fmt.Sprintf("accounts/%s/sshkeys", account)
 	var reply SSHKeyInfo
-	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, "accounts/self/sshkeys", nil, &reply,
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, nil, &reply,
 		PlainText(key)); err != nil {
 		return nil, err
 	}
 	return &reply, nil
 }
 
+// AddValidatedSSHKey Adds an SSH key for an account after validating it with ParseSSHKey.
+// The normalized key is uploaded, so leading authorized_keys options are dropped.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#add-ssh-key
+func (s *AccountsService) AddValidatedSSHKey(ctx context.Context, account, key string) (*SSHKeyInfo, error) {
+	parsed, err := ParseSSHKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return s.AddAccountSSHKey(ctx, account, parsed.SSHPublicKey)
+}
+
 // DeleteSSHKey Deletes an SSH key of self user.
 //
-// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-ssh-keys
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-ssh-key
 func (s *AccountsService) DeleteSSHKey(ctx context.Context, id int) error {
-	u := fmt.Sprintf("accounts/self/sshkeys/%d", id)
+	return s.DeleteAccountSSHKey(ctx, "self", id)
+}
+
+// DeleteAccountSSHKey Deletes an SSH key of an account by its sequence number.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-ssh-key
+func (s *AccountsService) DeleteAccountSSHKey(ctx context.Context, account string, seq int) error {
+	u := fmt.Sprintf("accounts/%s/sshkeys/%d", account, seq)
 	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
 		return err
 	}
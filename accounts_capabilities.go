@@ -0,0 +1,116 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Global capabilities of Gerrit core.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/access-control.html#global_capabilities
+const (
+	CapabilityAccessDatabase     = "accessDatabase"
+	CapabilityAdministrateServer = "administrateServer"
+	CapabilityCreateAccount      = "createAccount"
+	CapabilityCreateGroup        = "createGroup"
+	CapabilityCreateProject      = "createProject"
+	CapabilityEmailReviewers     = "emailReviewers"
+	CapabilityFlushCaches        = "flushCaches"
+	CapabilityKillTask           = "killTask"
+	CapabilityMaintainServer     = "maintainServer"
+	CapabilityModifyAccount      = "modifyAccount"
+	CapabilityPriority           = "priority"
+	CapabilityQueryLimit         = "queryLimit"
+	CapabilityRunAs              = "runAs"
+	CapabilityRunGC              = "runGC"
+	CapabilityStreamEvents       = "streamEvents"
+	CapabilityViewAccess         = "viewAccess"
+	CapabilityViewAllAccounts    = "viewAllAccounts"
+	CapabilityViewCaches         = "viewCaches"
+	CapabilityViewConnections    = "viewConnections"
+	CapabilityViewPlugins        = "viewPlugins"
+	CapabilityViewQueue          = "viewQueue"
+)
+
+// ErrMissingCapability is returned by RequireCapabilities if an account lacks a global capability.
+var ErrMissingCapability = errors.New("missing capability")
+
+// AccountCapabilities contains the global capabilities granted to an account, keyed by capability name.
+// Most capabilities are reported as true; queryLimit holds its range and priority its queue name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#capability-info
+type AccountCapabilities map[string]any
+
+// Has reports whether the capability is granted.
+func (c AccountCapabilities) Has(capability string) bool {
+	v, ok := c[capability]
+	if !ok || v == nil {
+		return false
+	}
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return true
+}
+
+// GetCapabilitiesOptions filters the capabilities returned by GetCapabilities.
+type GetCapabilitiesOptions struct {
+	// Filter restricts the result to the given capabilities.
+	Filter []string `query:"q,omitempty"`
+}
+
+// GetCapabilities returns the global capabilities that are enabled for the specified user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-account-capabilities
+func (s *AccountsService) GetCapabilities(ctx context.Context, account string, opts *GetCapabilitiesOptions) (AccountCapabilities, error) {
+	u := fmt.Sprintf("accounts/%s/capabilities", account)
+
+	var reply AccountCapabilities
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, opts, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// CheckCapability checks if a user has a certain global capability.
+// It reports false rather than an error if the capability is not granted.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#check-account-capability
+func (s *AccountsService) CheckCapability(ctx context.Context, account, capability string) (bool, error) {
+	u := fmt.Sprintf("accounts/%s/capabilities/%s", account, capability)
+
+	var reply string
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// RequireCapabilities checks in a single request that an account has all the given global capabilities.
+// If any is missing, the returned error wraps ErrMissingCapability and names all missing capabilities.
+func (s *AccountsService) RequireCapabilities(ctx context.Context, account string, capabilities ...string) error {
+	if len(capabilities) == 0 {
+		return nil
+	}
+	granted, err := s.GetCapabilities(ctx, account, &GetCapabilitiesOptions{Filter: capabilities})
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, c := range capabilities {
+		if !granted.Has(c) {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: account %s lacks %s", ErrMissingCapability, account, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package gerrit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_GetCapabilities(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetCapabilities(context.Background(), "self", &gerrit.GetCapabilitiesOptions{
+		Filter: []string{gerrit.CapabilityCreateProject, gerrit.CapabilityQueryLimit},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("capabilities: %+v", reply)
+}
+
+func TestAccountsService_CheckCapability(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	ok, err := client.Accounts.CheckCapability(context.Background(), "self", gerrit.CapabilityAdministrateServer)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("administrateServer: %v", ok)
+}
+
+func TestAccountsService_RequireCapabilities(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.RequireCapabilities(context.Background(), "self",
+		gerrit.CapabilityAdministrateServer,
+		gerrit.CapabilityCreateProject,
+		gerrit.CapabilityAccessDatabase,
+	)
+
+	if err != nil && !errors.Is(err, gerrit.ErrMissingCapability) {
+		t.Fatal(err)
+	}
+
+	t.Logf("require capabilities: %v", err)
+}
@@ -0,0 +1,222 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DuplicateKey is an account attribute used to detect duplicate accounts.
+type DuplicateKey string
+
+const (
+	// DuplicateByEmail matches accounts sharing a preferred or secondary email, ignoring case.
+	DuplicateByEmail DuplicateKey = "email"
+	// DuplicateByUsername matches accounts whose usernames only differ in case.
+	DuplicateByUsername DuplicateKey = "username"
+	// DuplicateByName matches accounts with the same full name, ignoring case and spacing.
+	// Names are not unique, so it is only used if requested explicitly,
+	// and DeactivateDuplicateAccounts refuses to act on it.
+	DuplicateByName DuplicateKey = "name"
+)
+
+// DuplicateAccounts is a set of accounts that likely belong to the same person.
+type DuplicateAccounts struct {
+	// Accounts are sorted by account ID, so the oldest account comes first.
+	Accounts []*AccountInfo
+	// Matches lists the attributes the accounts were matched on, e.g. "email:jdoe@example.com".
+	Matches []string
+}
+
+// FindDuplicateAccounts groups accounts that share an attribute of the given keys,
+// email and username if none are given.
+// Matches are transitive: if a shares an email with b and b a username with c, all three form one set.
+// Accounts without duplicates are not reported.
+func FindDuplicateAccounts(accounts []*AccountInfo, keys ...DuplicateKey) []*DuplicateAccounts {
+	if len(keys) == 0 {
+		keys = []DuplicateKey{DuplicateByEmail, DuplicateByUsername}
+	}
+
+	parent := make([]int, len(accounts))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	// owners maps a match key to the accounts having it.
+	owners := make(map[string][]int)
+	for i, a := range accounts {
+		if a == nil {
+			continue
+		}
+		for _, m := range duplicateMatches(a, keys) {
+			if prev := owners[m]; len(prev) > 0 {
+				parent[find(i)] = find(prev[0])
+			}
+			owners[m] = append(owners[m], i)
+		}
+	}
+
+	sets := make(map[int]*DuplicateAccounts)
+	var roots []int
+	for i, a := range accounts {
+		if a == nil {
+			continue
+		}
+		r := find(i)
+		d, ok := sets[r]
+		if !ok {
+			d = new(DuplicateAccounts)
+			sets[r] = d
+			roots = append(roots, r)
+		}
+		d.Accounts = append(d.Accounts, a)
+	}
+	for m, idx := range owners {
+		if len(idx) > 1 {
+			d := sets[find(idx[0])]
+			d.Matches = append(d.Matches, m)
+		}
+	}
+
+	var reply []*DuplicateAccounts
+	for _, r := range roots {
+		d := sets[r]
+		if len(d.Accounts) < 2 {
+			continue
+		}
+		sort.Slice(d.Accounts, func(i, j int) bool {
+			return d.Accounts[i].AccountID < d.Accounts[j].AccountID
+		})
+		sort.Strings(d.Matches)
+		reply = append(reply, d)
+	}
+	sort.Slice(reply, func(i, j int) bool {
+		return reply[i].Accounts[0].AccountID < reply[j].Accounts[0].AccountID
+	})
+	return reply
+}
+
+func duplicateMatches(a *AccountInfo, keys []DuplicateKey) []string {
+	seen := make(map[string]bool)
+	var reply []string
+	add := func(key DuplicateKey, value string) {
+		if value == "" {
+			return
+		}
+		m := string(key) + ":" + value
+		if !seen[m] {
+			seen[m] = true
+			reply = append(reply, m)
+		}
+	}
+	for _, key := range keys {
+		switch key {
+		case DuplicateByEmail:
+			add(key, strings.ToLower(strings.TrimSpace(a.Email)))
+			for _, e := range a.SecondaryEmails {
+				add(key, strings.ToLower(strings.TrimSpace(e)))
+			}
+		case DuplicateByUsername:
+			add(key, strings.ToLower(strings.TrimSpace(a.Username)))
+		case DuplicateByName:
+			add(key, strings.ToLower(strings.Join(strings.Fields(a.Name), " ")))
+		}
+	}
+	return reply
+}
+
+// QueryDuplicateAccounts pages through all accounts matching query, with details and all emails,
+// and groups likely duplicates as FindDuplicateAccounts does.
+func (s *AccountsService) QueryDuplicateAccounts(ctx context.Context, query string, keys ...DuplicateKey) ([]*DuplicateAccounts, error) {
+	opts := &QueryAccountsOptions{
+		ListOptions:      NewListOptions(0, 500),
+		AdditionalFields: []AccountAdditionalField{DETAILS, ALL_EMAILS},
+	}
+
+	var accounts []*AccountInfo
+	for {
+		reply, err := s.QueryAccounts(ctx, query, opts)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, reply...)
+		if len(reply) == 0 || !reply[len(reply)-1].MoreAccounts {
+			break
+		}
+		opts.Skip += len(reply)
+	}
+	return FindDuplicateAccounts(accounts, keys...), nil
+}
+
+// DeactivateDuplicateAccounts sets all active accounts of a duplicate set to inactive, except keep.
+// Only accounts that are duplicates of keep by the given keys are deactivated, so a set found with
+// DuplicateByName is narrowed down to the accounts that also match by e.g. email.
+// keys must not be empty and must not contain DuplicateByName.
+// It returns the accounts that were deactivated, also if it stops early because of an error.
+func (s *AccountsService) DeactivateDuplicateAccounts(ctx context.Context, duplicates *DuplicateAccounts, keep *AccountInfo, keys []DuplicateKey) ([]*AccountInfo, error) {
+	if duplicates == nil {
+		return nil, errors.New("no duplicates")
+	}
+	if keep == nil {
+		return nil, errors.New("no account to keep")
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no keys to match duplicates by")
+	}
+	if containsDuplicateKey(keys, DuplicateByName) {
+		return nil, errors.New("accounts are not deactivated based on their name")
+	}
+	found := false
+	for _, a := range duplicates.Accounts {
+		if a.AccountID == keep.AccountID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("account %d is not part of the duplicates", keep.AccountID)
+	}
+
+	var matched *DuplicateAccounts
+	for _, d := range FindDuplicateAccounts(duplicates.Accounts, keys...) {
+		for _, a := range d.Accounts {
+			if a.AccountID == keep.AccountID {
+				matched = d
+			}
+		}
+	}
+	if matched == nil {
+		return nil, fmt.Errorf("account %d has no duplicates by %v", keep.AccountID, keys)
+	}
+
+	var reply []*AccountInfo
+	for _, a := range matched.Accounts {
+		if a.AccountID == keep.AccountID || a.Inactive {
+			continue
+		}
+		if err := s.DeleteActive(ctx, strconv.Itoa(a.AccountID)); err != nil {
+			return reply, fmt.Errorf("deactivate account %d: %w", a.AccountID, err)
+		}
+		reply = append(reply, a)
+	}
+	return reply, nil
+}
+
+func containsDuplicateKey(keys []DuplicateKey, key DuplicateKey) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package gerrit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestFindDuplicateAccounts(t *testing.T) {
+	accounts := []*AccountInfo{
+		{AccountID: 1000, Name: "John Doe", Email: "jdoe@example.com", Username: "jdoe"},
+		{AccountID: 1003, Name: "Jane Roe", Email: "jroe@example.com", Username: "jroe"},
+		{AccountID: 1005, Name: "john  doe", Email: "john.doe@sso.example.com", SecondaryEmails: []string{"JDoe@example.com"}},
+		{AccountID: 1007, Name: "J. Doe", Username: "JDOE"},
+		{AccountID: 1009, Name: "Jane Roe", Email: "jane@example.com"},
+	}
+
+	got := FindDuplicateAccounts(accounts)
+	if len(got) != 1 {
+		t.Fatalf("got %d duplicate sets, want 1", len(got))
+	}
+
+	ids := func(d *DuplicateAccounts) []int {
+		var reply []int
+		for _, a := range d.Accounts {
+			reply = append(reply, a.AccountID)
+		}
+		return reply
+	}
+	if got, want := ids(got[0]), []int{1000, 1005, 1007}; !reflect.DeepEqual(got, want) {
+		t.Errorf("accounts: got %v, want %v", got, want)
+	}
+	if got, want := got[0].Matches, []string{"email:jdoe@example.com", "username:jdoe"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("matches: got %v, want %v", got, want)
+	}
+
+	byName := FindDuplicateAccounts(accounts, DuplicateByEmail, DuplicateByUsername, DuplicateByName)
+	if len(byName) != 2 {
+		t.Fatalf("by name: got %d duplicate sets, want 2", len(byName))
+	}
+	if got, want := byName[0].Matches, []string{"email:jdoe@example.com", "name:john doe", "username:jdoe"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("by name: matches: got %v, want %v", got, want)
+	}
+	if got, want := ids(byName[1]), []int{1003, 1009}; !reflect.DeepEqual(got, want) {
+		t.Errorf("by name: accounts: got %v, want %v", got, want)
+	}
+
+	byEmail := FindDuplicateAccounts(accounts, DuplicateByEmail)
+	if len(byEmail) != 1 || !reflect.DeepEqual(ids(byEmail[0]), []int{1000, 1005}) {
+		t.Errorf("by email: got %+v", byEmail)
+	}
+}
+
+func TestDeactivateDuplicateAccountsKeys(t *testing.T) {
+	jane := &AccountInfo{AccountID: 1003, Name: "Jane Roe", Email: "jroe@example.com"}
+	duplicates := &DuplicateAccounts{
+		Accounts: []*AccountInfo{jane, {AccountID: 1009, Name: "Jane Roe", Email: "jane@example.com"}},
+		Matches:  []string{"name:jane roe"},
+	}
+
+	// None of these reach the server.
+	var s AccountsService
+	for _, keys := range [][]DuplicateKey{
+		nil,
+		{DuplicateByEmail, DuplicateByName},
+		{DuplicateByEmail, DuplicateByUsername},
+	} {
+		if reply, err := s.DeactivateDuplicateAccounts(context.Background(), duplicates, jane, keys); err == nil {
+			t.Errorf("keys %v: want error, got %v", keys, reply)
+		}
+	}
+	if reply, err := s.DeactivateDuplicateAccounts(context.Background(), nil, jane, []DuplicateKey{DuplicateByEmail}); err == nil {
+		t.Errorf("nil duplicates: want error, got %v", reply)
+	}
+}
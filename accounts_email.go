@@ -0,0 +1,94 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EmailInfo entity contains information about an email address of a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#email-info
+type EmailInfo struct {
+	Email               string `json:"email"`
+	Preferred           bool   `json:"preferred,omitempty"`
+	PendingConfirmation bool   `json:"pending_confirmation,omitempty"`
+}
+
+// EmailInput entity contains information for registering a new email address.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#email-input
+type EmailInput struct {
+	// Whether the new email address should become the preferred email address of the user.
+	Preferred bool `json:"preferred,omitempty"`
+	// Whether the email address should be added without confirmation.
+	// Only allowed for administrators.
+	NoConfirmation bool `json:"no_confirmation,omitempty"`
+}
+
+// ListEmails returns the email addresses that are configured for the specified user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-account-emails
+func (s *AccountsService) ListEmails(ctx context.Context, account string) ([]*EmailInfo, error) {
+	u := fmt.Sprintf("accounts/%s/emails", account)
+
+	var reply []*EmailInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GetEmail retrieves an email address of a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-account-email
+func (s *AccountsService) GetEmail(ctx context.Context, account, email string) (*EmailInfo, error) {
+	u := fmt.Sprintf("accounts/%s/emails/%s", account, url.PathEscape(email))
+
+	var reply EmailInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// CreateEmail registers a new email address for the user.
+// A verification email is sent with a link that needs to be visited to confirm the email address,
+// unless input.NoConfirmation is set.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#create-account-email
+func (s *AccountsService) CreateEmail(ctx context.Context, account, email string, input *EmailInput) (*EmailInfo, error) {
+	u := fmt.Sprintf("accounts/%s/emails/%s", account, url.PathEscape(email))
+	if input == nil {
+		input = new(EmailInput)
+	}
+
+	var reply EmailInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// DeleteEmail deletes an email address of an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-account-email
+func (s *AccountsService) DeleteEmail(ctx context.Context, account, email string) error {
+	u := fmt.Sprintf("accounts/%s/emails/%s", account, url.PathEscape(email))
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetPreferredEmail sets an email address as preferred email address for an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-preferred-email
+func (s *AccountsService) SetPreferredEmail(ctx context.Context, account, email string) error {
+	u := fmt.Sprintf("accounts/%s/emails/%s/preferred", account, url.PathEscape(email))
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_ListEmails(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.ListEmails(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("emails: %v", len(reply))
+}
+
+func TestAccountsService_GetEmail(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetEmail(context.Background(), "ci-bot", "ci-bot@example.com")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("email: %+v", reply)
+}
+
+func TestAccountsService_CreateEmail(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.CreateEmail(context.Background(), "ci-bot", "ci@example.com", &gerrit.EmailInput{
+		NoConfirmation: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("email: %+v", reply)
+}
+
+func TestAccountsService_DeleteEmail(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteEmail(context.Background(), "ci-bot", "ci@example.com")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete email ok!")
+}
+
+func TestAccountsService_SetPreferredEmail(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.SetPreferredEmail(context.Background(), "ci-bot", "ci-bot@example.com")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("set preferred email ok!")
+}
@@ -0,0 +1,43 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AccountExternalIdInfo entity contains information for an external id of an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#account-external-id-info
+type AccountExternalIdInfo struct {
+	// Identity is the account external id, e.g. "username:jdoe" or "mailto:jdoe@example.com".
+	Identity     string `json:"identity"`
+	EmailAddress string `json:"email_address,omitempty"`
+	Trusted      bool   `json:"trusted,omitempty"`
+	CanDelete    bool   `json:"can_delete,omitempty"`
+}
+
+// GetExternalIDs retrieves the external ids of a user account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-account-external-ids
+func (s *AccountsService) GetExternalIDs(ctx context.Context, account string) ([]*AccountExternalIdInfo, error) {
+	u := fmt.Sprintf("accounts/%s/external.ids", account)
+
+	var reply []*AccountExternalIdInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// DeleteExternalIDs deletes the given external ids from a user account.
+// The external id used to log in cannot be deleted.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-account-external-ids
+func (s *AccountsService) DeleteExternalIDs(ctx context.Context, account string, identities []string) error {
+	u := fmt.Sprintf("accounts/%s/external.ids:delete", account)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, identities, nil); err != nil {
+		return err
+	}
+	return nil
+}
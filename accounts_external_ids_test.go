@@ -0,0 +1,54 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_GetExternalIDs(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetExternalIDs(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range reply {
+		t.Logf("external id: %+v", id)
+	}
+}
+
+func TestAccountsService_DeleteExternalIDs(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteExternalIDs(context.Background(), "self", []string{"mailto:test@example.com"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete external ids ok!")
+}
+
+func TestAccountsService_QueryDuplicateAccounts(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.QueryDuplicateAccounts(context.Background(), "is:active", gerrit.DuplicateByEmail, gerrit.DuplicateByUsername)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range reply {
+		t.Logf("duplicates: %v, %d accounts", d.Matches, len(d.Accounts))
+	}
+}
@@ -0,0 +1,72 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GpgKeyInfo entity contains information about a GPG public key.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#gpg-key-info
+type GpgKeyInfo struct {
+	ID          string   `json:"id,omitempty"`
+	Fingerprint string   `json:"fingerprint,omitempty"`
+	UserIDs     []string `json:"user_ids,omitempty"`
+	Key         string   `json:"key,omitempty"`
+	// Status is the result of server-side checks on the key; one of BAD, OK, or TRUSTED.
+	Status   string   `json:"status,omitempty"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+// ListGPGKeys returns the GPG keys of an account keyed by key ID.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#list-gpg-keys
+func (s *AccountsService) ListGPGKeys(ctx context.Context, account string) (map[string]*GpgKeyInfo, error) {
+	u := fmt.Sprintf("accounts/%s/gpgkeys", account)
+
+	var reply map[string]*GpgKeyInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GetGPGKey retrieves a GPG key of an account.
+// The key ID is the 8-character short ID, the 16-character long ID or the 40-character fingerprint.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-gpg-key
+func (s *AccountsService) GetGPGKey(ctx context.Context, account, keyID string) (*GpgKeyInfo, error) {
+	u := fmt.Sprintf("accounts/%s/gpgkeys/%s", account, keyID)
+
+	var reply GpgKeyInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// AddGPGKeys adds ASCII armored GPG public keys to an account.
+// It returns the added keys keyed by key ID.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#add-delete-gpg-keys
+func (s *AccountsService) AddGPGKeys(ctx context.Context, account string, keys []string) (map[string]*GpgKeyInfo, error) {
+	u := fmt.Sprintf("accounts/%s/gpgkeys", account)
+
+	var reply map[string]*GpgKeyInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, map[string][]string{"add": keys}, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// DeleteGPGKey deletes a GPG key of an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-gpg-key
+func (s *AccountsService) DeleteGPGKey(ctx context.Context, account, keyID string) error {
+	u := fmt.Sprintf("accounts/%s/gpgkeys/%s", account, keyID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
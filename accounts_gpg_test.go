@@ -0,0 +1,66 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_ListGPGKeys(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.ListGPGKeys(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("gpg keys: %v", len(reply))
+}
+
+func TestAccountsService_GetGPGKey(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetGPGKey(context.Background(), "self", "AFC8A49B")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("gpg key: %+v", reply)
+}
+
+func TestAccountsService_AddGPGKeys(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.AddGPGKeys(context.Background(), "self", []string{
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----\n",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("gpg keys: %v", reply)
+}
+
+func TestAccountsService_DeleteGPGKey(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteGPGKey(context.Background(), "self", "AFC8A49B")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete gpg key ok!")
+}
@@ -0,0 +1,103 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// HTTPPasswordInput entity contains information for setting/generating an HTTP password.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#http-password-input
+type HTTPPasswordInput struct {
+	Generate     bool   `json:"generate,omitempty"`
+	HTTPPassword string `json:"http_password,omitempty"`
+}
+
+// GenerateHTTPPassword generates a new HTTP password for an account and returns it.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-http-password
+func (s *AccountsService) GenerateHTTPPassword(ctx context.Context, account string) (string, error) {
+	u := fmt.Sprintf("accounts/%s/password.http", account)
+	return s.client.putString(ctx, u, &HTTPPasswordInput{Generate: true})
+}
+
+// SetHTTPPassword sets the HTTP password of an account and returns it.
+// Only Gerrit administrators may set the HTTP password directly.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-http-password
+func (s *AccountsService) SetHTTPPassword(ctx context.Context, account, password string) (string, error) {
+	u := fmt.Sprintf("accounts/%s/password.http", account)
+	return s.client.putString(ctx, u, &HTTPPasswordInput{HTTPPassword: password})
+}
+
+// DeleteHTTPPassword deletes the HTTP password of an account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-http-password
+func (s *AccountsService) DeleteHTTPPassword(ctx context.Context, account string) error {
+	u := fmt.Sprintf("accounts/%s/password.http", account)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// HTTPPasswordVerifyError is returned by RotateHTTPPassword if the new password was generated,
+// but could not be verified to authenticate as the account.
+type HTTPPasswordVerifyError struct {
+	// Password is the generated password, which replaced the old one on the server.
+	Password string
+	Err      error
+}
+
+func (e *HTTPPasswordVerifyError) Error() string {
+	return fmt.Sprintf("verify new HTTP password: %v", e.Err)
+}
+
+func (e *HTTPPasswordVerifyError) Unwrap() error {
+	return e.Err
+}
+
+// RotateHTTPPassword generates a new HTTP password for an account and returns it
+// once it has been verified to authenticate as that account.
+//
+// The old password stops working as soon as the new one is generated.
+// If the verification fails, a *HTTPPasswordVerifyError holding the new password is returned,
+// so that it is not lost.
+func (s *AccountsService) RotateHTTPPassword(ctx context.Context, account string) (string, error) {
+	info, err := s.GetAccount(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	if info.Username == "" {
+		return "", fmt.Errorf("account %s has no username to authenticate with", account)
+	}
+
+	password, err := s.GenerateHTTPPassword(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	if password == "" {
+		return "", errors.New("no HTTP password was generated")
+	}
+
+	var endpoint string
+	if s.client.credential != nil {
+		endpoint = s.client.credential.GetEndpoint()
+	}
+	verify := s.client.withCredential(&PasswordCredential{
+		Endpoint: endpoint,
+		Username: info.Username,
+		Password: password,
+	})
+	self, err := verify.Accounts.GetAccount(ctx, "self")
+	if err != nil {
+		return "", &HTTPPasswordVerifyError{Password: password, Err: err}
+	}
+	if self.AccountID != info.AccountID {
+		err := fmt.Errorf("authenticated as account %d, want %d", self.AccountID, info.AccountID)
+		return "", &HTTPPasswordVerifyError{Password: password, Err: err}
+	}
+	return password, nil
+}
@@ -0,0 +1,64 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_GenerateHTTPPassword(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GenerateHTTPPassword(context.Background(), "ci-bot")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("password: %v", reply)
+}
+
+func TestAccountsService_SetHTTPPassword(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetHTTPPassword(context.Background(), "ci-bot", "secret")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("password: %v", reply)
+}
+
+func TestAccountsService_DeleteHTTPPassword(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteHTTPPassword(context.Background(), "ci-bot")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete http password ok!")
+}
+
+func TestAccountsService_RotateHTTPPassword(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.RotateHTTPPassword(context.Background(), "ci-bot")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("password: %v", reply)
+}
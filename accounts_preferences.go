@@ -0,0 +1,282 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EmailStrategy controls which emails Gerrit sends to a user.
+type EmailStrategy string
+
+const (
+	EmailEnabled          EmailStrategy = "ENABLED"
+	EmailCCOnOwnComments  EmailStrategy = "CC_ON_OWN_COMMENTS"
+	EmailAttentionSetOnly EmailStrategy = "ATTENTION_SET_ONLY"
+	EmailDisabled         EmailStrategy = "DISABLED"
+)
+
+// DiffView is the type of diff view shown to a user.
+type DiffView string
+
+const (
+	SideBySide  DiffView = "SIDE_BY_SIDE"
+	UnifiedDiff DiffView = "UNIFIED_DIFF"
+)
+
+// IgnoreWhitespace controls whether whitespace changes are ignored in diffs.
+type IgnoreWhitespace string
+
+const (
+	IgnoreNone               IgnoreWhitespace = "IGNORE_NONE"
+	IgnoreTrailing           IgnoreWhitespace = "IGNORE_TRAILING"
+	IgnoreLeadingAndTrailing IgnoreWhitespace = "IGNORE_LEADING_AND_TRAILING"
+	IgnoreAll                IgnoreWhitespace = "IGNORE_ALL"
+)
+
+// TopMenuItemInfo entity contains information about a menu item in a top menu entry.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#top-menu-item-info
+type TopMenuItemInfo struct {
+	URL    string `json:"url"`
+	Name   string `json:"name"`
+	Target string `json:"target"`
+	ID     string `json:"id,omitempty"`
+}
+
+// PreferencesInfo entity contains information about a user's preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#preferences-info
+type PreferencesInfo struct {
+	ChangesPerPage            int               `json:"changes_per_page"`
+	Theme                     string            `json:"theme,omitempty"`
+	ExpandInlineDiffs         bool              `json:"expand_inline_diffs,omitempty"`
+	DownloadScheme            string            `json:"download_scheme,omitempty"`
+	DateFormat                string            `json:"date_format,omitempty"`
+	TimeFormat                string            `json:"time_format,omitempty"`
+	RelativeDateInChangeTable bool              `json:"relative_date_in_change_table,omitempty"`
+	DiffView                  DiffView          `json:"diff_view,omitempty"`
+	SizeBarInChangeTable      bool              `json:"size_bar_in_change_table,omitempty"`
+	LegacycidInChangeTable    bool              `json:"legacycid_in_change_table,omitempty"`
+	MuteCommonPathPrefixes    bool              `json:"mute_common_path_prefixes,omitempty"`
+	SignedOffBy               bool              `json:"signed_off_by,omitempty"`
+	My                        []TopMenuItemInfo `json:"my,omitempty"`
+	ChangeTable               []string          `json:"change_table,omitempty"`
+	EmailStrategy             EmailStrategy     `json:"email_strategy,omitempty"`
+	EmailFormat               string            `json:"email_format,omitempty"`
+	DefaultBaseForMerges      string            `json:"default_base_for_merges,omitempty"`
+	PublishCommentsOnPush     bool              `json:"publish_comments_on_push,omitempty"`
+	DisableKeyboardShortcuts  bool              `json:"disable_keyboard_shortcuts,omitempty"`
+	DisableTokenHighlighting  bool              `json:"disable_token_highlighting,omitempty"`
+	WorkInProgressByDefault   bool              `json:"work_in_progress_by_default,omitempty"`
+	AllowBrowserNotifications bool              `json:"allow_browser_notifications,omitempty"`
+	DiffPageSidebar           string            `json:"diff_page_sidebar,omitempty"`
+}
+
+// PreferencesInput entity contains information for setting the user preferences.
+// Fields that are not set are not modified.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#preferences-input
+type PreferencesInput struct {
+	ChangesPerPage            *int              `json:"changes_per_page,omitempty"`
+	Theme                     *string           `json:"theme,omitempty"`
+	ExpandInlineDiffs         *bool             `json:"expand_inline_diffs,omitempty"`
+	DownloadScheme            *string           `json:"download_scheme,omitempty"`
+	DateFormat                *string           `json:"date_format,omitempty"`
+	TimeFormat                *string           `json:"time_format,omitempty"`
+	RelativeDateInChangeTable *bool             `json:"relative_date_in_change_table,omitempty"`
+	DiffView                  *DiffView         `json:"diff_view,omitempty"`
+	SizeBarInChangeTable      *bool             `json:"size_bar_in_change_table,omitempty"`
+	LegacycidInChangeTable    *bool             `json:"legacycid_in_change_table,omitempty"`
+	MuteCommonPathPrefixes    *bool             `json:"mute_common_path_prefixes,omitempty"`
+	SignedOffBy               *bool             `json:"signed_off_by,omitempty"`
+	My                        []TopMenuItemInfo `json:"my,omitempty"`
+	ChangeTable               []string          `json:"change_table,omitempty"`
+	EmailStrategy             *EmailStrategy    `json:"email_strategy,omitempty"`
+	EmailFormat               *string           `json:"email_format,omitempty"`
+	DefaultBaseForMerges      *string           `json:"default_base_for_merges,omitempty"`
+	PublishCommentsOnPush     *bool             `json:"publish_comments_on_push,omitempty"`
+	DisableKeyboardShortcuts  *bool             `json:"disable_keyboard_shortcuts,omitempty"`
+	DisableTokenHighlighting  *bool             `json:"disable_token_highlighting,omitempty"`
+	WorkInProgressByDefault   *bool             `json:"work_in_progress_by_default,omitempty"`
+	AllowBrowserNotifications *bool             `json:"allow_browser_notifications,omitempty"`
+	DiffPageSidebar           *string           `json:"diff_page_sidebar,omitempty"`
+}
+
+// DiffPreferencesInfo entity contains information about the diff preferences of a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#diff-preferences-info
+type DiffPreferencesInfo struct {
+	Context                 int              `json:"context"`
+	ExpandAllComments       bool             `json:"expand_all_comments,omitempty"`
+	IgnoreWhitespace        IgnoreWhitespace `json:"ignore_whitespace,omitempty"`
+	IntralineDifference     bool             `json:"intraline_difference,omitempty"`
+	LineLength              int              `json:"line_length"`
+	CursorBlinkRate         int              `json:"cursor_blink_rate,omitempty"`
+	ManualReview            bool             `json:"manual_review,omitempty"`
+	RetainHeader            bool             `json:"retain_header,omitempty"`
+	ShowLineEndings         bool             `json:"show_line_endings,omitempty"`
+	ShowTabs                bool             `json:"show_tabs,omitempty"`
+	ShowWhitespaceErrors    bool             `json:"show_whitespace_errors,omitempty"`
+	SkipDeleted             bool             `json:"skip_deleted,omitempty"`
+	SkipUncommented         bool             `json:"skip_uncommented,omitempty"`
+	SkipUnchanged           bool             `json:"skip_unchanged,omitempty"`
+	SyntaxHighlighting      bool             `json:"syntax_highlighting,omitempty"`
+	HideTopMenu             bool             `json:"hide_top_menu,omitempty"`
+	AutoHideDiffTableHeader bool             `json:"auto_hide_diff_table_header,omitempty"`
+	HideLineNumbers         bool             `json:"hide_line_numbers,omitempty"`
+	TabSize                 int              `json:"tab_size"`
+	FontSize                int              `json:"font_size,omitempty"`
+	HideEmptyPane           bool             `json:"hide_empty_pane,omitempty"`
+	MatchBrackets           bool             `json:"match_brackets,omitempty"`
+	LineWrapping            bool             `json:"line_wrapping,omitempty"`
+}
+
+// DiffPreferencesInput entity contains information for setting the diff preferences of a user.
+// Fields that are not set are not modified.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#diff-preferences-input
+type DiffPreferencesInput struct {
+	Context                 *int              `json:"context,omitempty"`
+	ExpandAllComments       *bool             `json:"expand_all_comments,omitempty"`
+	IgnoreWhitespace        *IgnoreWhitespace `json:"ignore_whitespace,omitempty"`
+	IntralineDifference     *bool             `json:"intraline_difference,omitempty"`
+	LineLength              *int              `json:"line_length,omitempty"`
+	CursorBlinkRate         *int              `json:"cursor_blink_rate,omitempty"`
+	ManualReview            *bool             `json:"manual_review,omitempty"`
+	RetainHeader            *bool             `json:"retain_header,omitempty"`
+	ShowLineEndings         *bool             `json:"show_line_endings,omitempty"`
+	ShowTabs                *bool             `json:"show_tabs,omitempty"`
+	ShowWhitespaceErrors    *bool             `json:"show_whitespace_errors,omitempty"`
+	SkipDeleted             *bool             `json:"skip_deleted,omitempty"`
+	SkipUncommented         *bool             `json:"skip_uncommented,omitempty"`
+	SkipUnchanged           *bool             `json:"skip_unchanged,omitempty"`
+	SyntaxHighlighting      *bool             `json:"syntax_highlighting,omitempty"`
+	HideTopMenu             *bool             `json:"hide_top_menu,omitempty"`
+	AutoHideDiffTableHeader *bool             `json:"auto_hide_diff_table_header,omitempty"`
+	HideLineNumbers         *bool             `json:"hide_line_numbers,omitempty"`
+	TabSize                 *int              `json:"tab_size,omitempty"`
+	FontSize                *int              `json:"font_size,omitempty"`
+	HideEmptyPane           *bool             `json:"hide_empty_pane,omitempty"`
+	MatchBrackets           *bool             `json:"match_brackets,omitempty"`
+	LineWrapping            *bool             `json:"line_wrapping,omitempty"`
+}
+
+// EditPreferencesInfo entity contains information about the edit preferences of a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#edit-preferences-info
+type EditPreferencesInfo struct {
+	TabSize              int  `json:"tab_size"`
+	LineLength           int  `json:"line_length"`
+	IndentUnit           int  `json:"indent_unit"`
+	CursorBlinkRate      int  `json:"cursor_blink_rate"`
+	HideTopMenu          bool `json:"hide_top_menu,omitempty"`
+	ShowTabs             bool `json:"show_tabs,omitempty"`
+	ShowWhitespaceErrors bool `json:"show_whitespace_errors,omitempty"`
+	SyntaxHighlighting   bool `json:"syntax_highlighting,omitempty"`
+	HideLineNumbers      bool `json:"hide_line_numbers,omitempty"`
+	MatchBrackets        bool `json:"match_brackets,omitempty"`
+	LineWrapping         bool `json:"line_wrapping,omitempty"`
+	IndentWithTabs       bool `json:"indent_with_tabs,omitempty"`
+	AutoCloseBrackets    bool `json:"auto_close_brackets,omitempty"`
+	ShowBase             bool `json:"show_base,omitempty"`
+}
+
+// EditPreferencesInput entity contains information for setting the edit preferences of a user.
+// Fields that are not set are not modified.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#edit-preferences-info
+type EditPreferencesInput struct {
+	TabSize              *int  `json:"tab_size,omitempty"`
+	LineLength           *int  `json:"line_length,omitempty"`
+	IndentUnit           *int  `json:"indent_unit,omitempty"`
+	CursorBlinkRate      *int  `json:"cursor_blink_rate,omitempty"`
+	HideTopMenu          *bool `json:"hide_top_menu,omitempty"`
+	ShowTabs             *bool `json:"show_tabs,omitempty"`
+	ShowWhitespaceErrors *bool `json:"show_whitespace_errors,omitempty"`
+	SyntaxHighlighting   *bool `json:"syntax_highlighting,omitempty"`
+	HideLineNumbers      *bool `json:"hide_line_numbers,omitempty"`
+	MatchBrackets        *bool `json:"match_brackets,omitempty"`
+	LineWrapping         *bool `json:"line_wrapping,omitempty"`
+	IndentWithTabs       *bool `json:"indent_with_tabs,omitempty"`
+	AutoCloseBrackets    *bool `json:"auto_close_brackets,omitempty"`
+	ShowBase             *bool `json:"show_base,omitempty"`
+}
+
+// GetPreferences retrieves the user's preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-user-preferences
+func (s *AccountsService) GetPreferences(ctx context.Context, account string) (*PreferencesInfo, error) {
+	u := fmt.Sprintf("accounts/%s/preferences", account)
+
+	var reply PreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// SetPreferences sets the user's preferences and returns the resulting preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-user-preferences
+func (s *AccountsService) SetPreferences(ctx context.Context, account string, input *PreferencesInput) (*PreferencesInfo, error) {
+	u := fmt.Sprintf("accounts/%s/preferences", account)
+
+	var reply PreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GetDiffPreferences retrieves the diff preferences of a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-diff-preferences
+func (s *AccountsService) GetDiffPreferences(ctx context.Context, account string) (*DiffPreferencesInfo, error) {
+	u := fmt.Sprintf("accounts/%s/preferences.diff", account)
+
+	var reply DiffPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// SetDiffPreferences sets the diff preferences of a user and returns the resulting preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-diff-preferences
+func (s *AccountsService) SetDiffPreferences(ctx context.Context, account string, input *DiffPreferencesInput) (*DiffPreferencesInfo, error) {
+	u := fmt.Sprintf("accounts/%s/preferences.diff", account)
+
+	var reply DiffPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GetEditPreferences retrieves the edit preferences of a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-edit-preferences
+func (s *AccountsService) GetEditPreferences(ctx context.Context, account string) (*EditPreferencesInfo, error) {
+	u := fmt.Sprintf("accounts/%s/preferences.edit", account)
+
+	var reply EditPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// SetEditPreferences sets the edit preferences of a user and returns the resulting preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-edit-preferences
+func (s *AccountsService) SetEditPreferences(ctx context.Context, account string, input *EditPreferencesInput) (*EditPreferencesInfo, error) {
+	u := fmt.Sprintf("accounts/%s/preferences.edit", account)
+
+	var reply EditPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
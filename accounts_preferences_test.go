@@ -0,0 +1,100 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestAccountsService_GetPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetPreferences(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestAccountsService_SetPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetPreferences(context.Background(), "self", &gerrit.PreferencesInput{
+		EmailStrategy: ptr.Ptr(gerrit.EmailAttentionSetOnly),
+		DiffView:      ptr.Ptr(gerrit.SideBySide),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestAccountsService_GetDiffPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetDiffPreferences(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestAccountsService_SetDiffPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetDiffPreferences(context.Background(), "self", &gerrit.DiffPreferencesInput{
+		IgnoreWhitespace: ptr.Ptr(gerrit.IgnoreTrailing),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestAccountsService_GetEditPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetEditPreferences(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestAccountsService_SetEditPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetEditPreferences(context.Background(), "self", &gerrit.EditPreferencesInput{
+		TabSize: ptr.Ptr(4),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
@@ -0,0 +1,165 @@
+package gerrit
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sshKeyAlgorithms lists the public key algorithms accepted by Gerrit's SSH daemon.
+var sshKeyAlgorithms = map[string]bool{
+	"ssh-rsa":                            true,
+	"ssh-dss":                            true,
+	"ssh-ed25519":                        true,
+	"ecdsa-sha2-nistp256":                true,
+	"ecdsa-sha2-nistp384":                true,
+	"ecdsa-sha2-nistp521":                true,
+	"sk-ssh-ed25519@openssh.com":         true,
+	"sk-ecdsa-sha2-nistp256@openssh.com": true,
+}
+
+const (
+	rfc4716Begin = "---- BEGIN SSH2 PUBLIC KEY ----"
+	rfc4716End   = "---- END SSH2 PUBLIC KEY ----"
+)
+
+// ParseSSHKey parses a public key, e.g. "ssh-ed25519 AAAA... user@host".
+// Both the OpenSSH authorized_keys format, with or without leading options,
+// and the multi-line RFC 4716 format are accepted.
+//
+// It checks that the algorithm is supported and that the encoded key is well-formed and of that algorithm,
+// and returns an SSHKeyInfo with Algorithm, EncodedKey, Comment and the normalized SSHPublicKey filled in.
+// The normalized key is a single OpenSSH line without options.
+func ParseSSHKey(key string) (*SSHKeyInfo, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, errors.New("ssh key: empty key")
+	}
+	if strings.Contains(key, "PRIVATE KEY") {
+		return nil, errors.New("ssh key: got a private key, want a public key")
+	}
+	if strings.HasPrefix(key, rfc4716Begin) {
+		return parseRFC4716Key(key)
+	}
+	if strings.ContainsAny(key, "\r\n") {
+		return nil, errors.New("ssh key: key must be a single line")
+	}
+
+	fields := strings.Fields(key)
+	if len(fields) > 0 && !sshKeyAlgorithms[fields[0]] {
+		if rest := strings.Fields(skipSSHKeyOptions(key)); len(rest) > 0 && sshKeyAlgorithms[rest[0]] {
+			fields = rest
+		}
+	}
+	if len(fields) < 2 {
+		return nil, errors.New("ssh key: want algorithm and encoded key")
+	}
+	algorithm, encoded := fields[0], fields[1]
+	if !sshKeyAlgorithms[algorithm] {
+		return nil, fmt.Errorf("ssh key: unsupported algorithm %q", algorithm)
+	}
+	inner, err := sshKeyBlobType(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if inner != algorithm {
+		return nil, fmt.Errorf("ssh key: encoded key is of type %q, not %q", inner, algorithm)
+	}
+	return newSSHKeyInfo(algorithm, encoded, strings.Join(fields[2:], " ")), nil
+}
+
+// parseRFC4716Key parses a key in the RFC 4716 format:
+//
+//	---- BEGIN SSH2 PUBLIC KEY ----
+//	Comment: "user@host"
+//	AAAA...
+//	---- END SSH2 PUBLIC KEY ----
+func parseRFC4716Key(key string) (*SSHKeyInfo, error) {
+	lines := strings.Split(strings.ReplaceAll(key, "\r\n", "\n"), "\n")
+	if strings.TrimSpace(lines[len(lines)-1]) != rfc4716End {
+		return nil, errors.New("ssh key: missing RFC 4716 end marker")
+	}
+	lines = lines[1 : len(lines)-1]
+
+	var comment string
+	var encoded strings.Builder
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		tag, value, isHeader := strings.Cut(line, ":")
+		if !isHeader {
+			encoded.WriteString(line)
+			continue
+		}
+		for strings.HasSuffix(value, "\\") && i+1 < len(lines) {
+			i++
+			value = strings.TrimSuffix(value, "\\") + strings.TrimSpace(lines[i])
+		}
+		if strings.EqualFold(strings.TrimSpace(tag), "Comment") {
+			value = strings.TrimSpace(value)
+			if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+				value = value[1 : len(value)-1]
+			}
+			comment = value
+		}
+	}
+
+	algorithm, err := sshKeyBlobType(encoded.String())
+	if err != nil {
+		return nil, err
+	}
+	if !sshKeyAlgorithms[algorithm] {
+		return nil, fmt.Errorf("ssh key: unsupported algorithm %q", algorithm)
+	}
+	return newSSHKeyInfo(algorithm, encoded.String(), comment), nil
+}
+
+// skipSSHKeyOptions returns the rest of an authorized_keys line after its leading options,
+// like `no-pty,command="echo hello" ssh-ed25519 AAAA...`.
+func skipSSHKeyOptions(line string) string {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ' ', '\t':
+			if !quoted {
+				return line[i:]
+			}
+		}
+	}
+	return ""
+}
+
+// sshKeyBlobType decodes a base64 encoded public key and returns the key type encoded in it.
+func sshKeyBlobType(encoded string) (string, error) {
+	blob, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("ssh key: invalid encoded key: %w", err)
+	}
+	if len(blob) < 4 {
+		return "", errors.New("ssh key: encoded key is too short")
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(n) > uint64(len(blob)-4) {
+		return "", errors.New("ssh key: encoded key is truncated")
+	}
+	return string(blob[4 : 4+n]), nil
+}
+
+func newSSHKeyInfo(algorithm, encoded, comment string) *SSHKeyInfo {
+	info := &SSHKeyInfo{
+		Algorithm:  algorithm,
+		EncodedKey: encoded,
+		Comment:    comment,
+		Valid:      true,
+	}
+	info.SSHPublicKey = algorithm + " " + encoded
+	if comment != "" {
+		info.SSHPublicKey += " " + comment
+	}
+	return info
+}
@@ -0,0 +1,43 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListStarredChanges gets the changes that were starred by the identified user account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-starred-changes
+func (s *AccountsService) ListStarredChanges(ctx context.Context, account string) ([]*ChangeInfo, error) {
+	u := fmt.Sprintf("accounts/%s/starred.changes", account)
+
+	var reply []*ChangeInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// StarChange stars a change.
+// Starred changes are returned for the search query is:starred or starredby:USER.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#star-change
+func (s *AccountsService) StarChange(ctx context.Context, account, changeID string) error {
+	u := fmt.Sprintf("accounts/%s/starred.changes/%s", account, changeID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnstarChange unstars a change.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#unstar-change
+func (s *AccountsService) UnstarChange(ctx context.Context, account, changeID string) error {
+	u := fmt.Sprintf("accounts/%s/starred.changes/%s", account, changeID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
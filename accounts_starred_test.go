@@ -0,0 +1,50 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_ListStarredChanges(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.ListStarredChanges(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("starred changes: %v", len(reply))
+}
+
+func TestAccountsService_StarChange(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.StarChange(context.Background(), "self", "test-1~1")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("star change ok!")
+}
+
+func TestAccountsService_UnstarChange(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.UnstarChange(context.Background(), "self", "test-1~1")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("unstar change ok!")
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
 )
 
 func TestAccountsService_QueryAccounts(t *testing.T) {
@@ -135,3 +136,175 @@ func TestAccountsService_DeleteSSHKey(t *testing.T) {
 
 	t.Logf("delete ssh key ok!")
 }
+
+func TestAccountsService_CreateAccount(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.CreateAccount(context.Background(), "ci-bot", &gerrit.AccountInput{
+		Name:   ptr.Ptr("CI Bot"),
+		Email:  ptr.Ptr("ci-bot@example.com"),
+		Groups: []string{"Service Users"},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("account: %+v", reply)
+}
+
+func TestAccountsService_GetAccountDetail(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetAccountDetail(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("account: %+v", reply)
+}
+
+func TestAccountsService_SetName(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetName(context.Background(), "ci-bot", "CI Bot")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("name: %v", reply)
+}
+
+func TestAccountsService_DeleteName(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteName(context.Background(), "ci-bot")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete name ok!")
+}
+
+func TestAccountsService_SetUsername(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetUsername(context.Background(), "1000001", "ci-bot")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("username: %v", reply)
+}
+
+func TestAccountsService_SetDisplayName(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetDisplayName(context.Background(), "ci-bot", "CI")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("display name: %v", reply)
+}
+
+func TestAccountsService_SetStatus(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.SetStatus(context.Background(), "ci-bot", "Service account")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("status: %v", reply)
+}
+
+func TestAccountsService_ListAccountSSHKeys(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.ListAccountSSHKeys(context.Background(), "1000001")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("ssh keys: %v", len(reply))
+}
+
+func TestAccountsService_GetSSHKey(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetSSHKey(context.Background(), "1000001", 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("ssh key: %v", reply)
+}
+
+func TestAccountsService_AddAccountSSHKey(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.AddAccountSSHKey(context.Background(), "1000001", "ssh-rsa AAAAB3Nz...")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("ssh key: %v", reply)
+}
+
+func TestAccountsService_AddValidatedSSHKey(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.AddValidatedSSHKey(context.Background(), "1000001", "ssh-rsa AAAAB3Nz...")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("ssh key: %v", reply)
+}
+
+func TestAccountsService_DeleteAccountSSHKey(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteAccountSSHKey(context.Background(), "1000001", 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete ssh key ok!")
+}
@@ -0,0 +1,60 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ProjectWatchInfo entity contains information about a project watch for a user.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#project-watch-info
+type ProjectWatchInfo struct {
+	Project string `json:"project"`
+	// Filter is a change query restricting the watch, e.g. built with the Query helpers.
+	Filter                 string `json:"filter,omitempty"`
+	NotifyNewChanges       bool   `json:"notify_new_changes,omitempty"`
+	NotifyNewPatchSets     bool   `json:"notify_new_patch_sets,omitempty"`
+	NotifyAllComments      bool   `json:"notify_all_comments,omitempty"`
+	NotifySubmittedChanges bool   `json:"notify_submitted_changes,omitempty"`
+	NotifyAbandonedChanges bool   `json:"notify_abandoned_changes,omitempty"`
+}
+
+// GetWatchedProjects retrieves all projects a user is watching.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#get-watched-projects
+func (s *AccountsService) GetWatchedProjects(ctx context.Context, account string) ([]*ProjectWatchInfo, error) {
+	u := fmt.Sprintf("accounts/%s/watched.projects", account)
+
+	var reply []*ProjectWatchInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// SetWatchedProjects adds new projects to watch or updates existing watches, and returns all watched projects.
+// A watch is identified by its project and filter.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#set-watched-projects
+func (s *AccountsService) SetWatchedProjects(ctx context.Context, account string, watches []*ProjectWatchInfo) ([]*ProjectWatchInfo, error) {
+	u := fmt.Sprintf("accounts/%s/watched.projects", account)
+
+	var reply []*ProjectWatchInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, watches, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// DeleteWatchedProjects deletes project watches of a user.
+// Only the project and filter of the watches are used to identify them.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#delete-watched-projects
+func (s *AccountsService) DeleteWatchedProjects(ctx context.Context, account string, watches []*ProjectWatchInfo) error {
+	u := fmt.Sprintf("accounts/%s/watched.projects:delete", account)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, watches, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestAccountsService_GetWatchedProjects(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Accounts.GetWatchedProjects(context.Background(), "self")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("watched projects: %v", len(reply))
+}
+
+func TestAccountsService_SetWatchedProjects(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	filter := gerrit.And(
+		gerrit.F("branch", "main"),
+		gerrit.Not(gerrit.F("owner", "self")),
+	)
+
+	reply, err := client.Accounts.SetWatchedProjects(context.Background(), "self", []*gerrit.ProjectWatchInfo{
+		{
+			Project:            "test-1",
+			Filter:             filter.String(),
+			NotifyNewChanges:   true,
+			NotifyNewPatchSets: true,
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("watched projects: %v", len(reply))
+}
+
+func TestAccountsService_DeleteWatchedProjects(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Accounts.DeleteWatchedProjects(context.Background(), "self", []*gerrit.ProjectWatchInfo{
+		{Project: "test-1"},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete watched projects ok!")
+}
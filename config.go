@@ -2,6 +2,7 @@ package gerrit
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 )
 
@@ -39,6 +40,14 @@ type AuthInfo struct {
 	IsGitBasicAuth           bool     `json:"is_git_basic_auth,omitempty"`
 }
 
+// AccountsInfo entity contains information about Gerrit configuration from the accounts section.
+type AccountsInfo struct {
+	// Visibility is the visibility of accounts: ALL, SAME_GROUP, VISIBLE_GROUP or NONE.
+	Visibility string `json:"visibility"`
+	// DefaultDisplayName is the default display name format: FULL_NAME, FIRST_NAME or USERNAME.
+	DefaultDisplayName string `json:"default_display_name,omitempty"`
+}
+
 // ChangeConfigInfo entity contains information about Gerrit configuration from the change section.
 type ChangeConfigInfo struct {
 	AllowDrafts      bool   `json:"allow_drafts,omitempty"`
@@ -47,6 +56,12 @@ type ChangeConfigInfo struct {
 	ReplyTooltip     string `json:"reply_tooltip"`
 	UpdateDelay      int    `json:"update_delay"`
 	SubmitWholeTopic bool   `json:"submit_whole_topic"`
+	// DisablePrivateChanges reports whether private changes are disabled.
+	DisablePrivateChanges bool `json:"disable_private_changes,omitempty"`
+	// MergeabilityComputationBehavior is one of API_REF_UPDATED_AND_CHANGE_REINDEX, REF_UPDATED_AND_CHANGE_REINDEX or NEVER.
+	MergeabilityComputationBehavior string `json:"mergeability_computation_behavior,omitempty"`
+	EnableAttentionSet              bool   `json:"enable_attention_set,omitempty"`
+	EnableRobotComments             bool   `json:"enable_robot_comments,omitempty"`
 }
 
 // DownloadSchemeInfo entity contains information about a supported download scheme and its commands.
@@ -82,9 +97,12 @@ type ReceiveInfo struct {
 type PluginConfigInfo struct {
 	// HasAvatars reports whether an avatar provider is registered.
 	HasAvatars bool `json:"has_avatars,omitempty"`
+	// JsResourcePaths are the paths of the JavaScript files of the web UI plugins, relative to the server URL.
+	JsResourcePaths []string `json:"js_resource_paths,omitempty"`
 }
 
 // SshdInfo entity contains information about Gerrit configuration from the sshd section.
+// It has no fields, its presence in ServerInfo means that the SSH daemon is enabled, see ServerInfo.HasSSHd.
 type SshdInfo struct{}
 
 // SuggestInfo entity contains information about Gerrit configuration from the suggest section.
@@ -99,6 +117,7 @@ type UserConfigInfo struct {
 
 // ServerInfo entity contains information about the configuration of the Gerrit server.
 type ServerInfo struct {
+	Accounts   AccountsInfo      `json:"accounts"`
 	Auth       AuthInfo          `json:"auth"`
 	Change     ChangeConfigInfo  `json:"change"`
 	Download   DownloadInfo      `json:"download"`
@@ -110,6 +129,25 @@ type ServerInfo struct {
 	Suggest    SuggestInfo       `json:"suggest"`
 	URLAliases map[string]string `json:"url_aliases,omitempty"`
 	User       UserConfigInfo    `json:"user"`
+
+	// HasSSHd reports whether the sshd section is present, that is whether the SSH daemon is enabled.
+	// It is set when the server info is decoded.
+	HasSSHd bool `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (i *ServerInfo) UnmarshalJSON(b []byte) error {
+	type serverInfo ServerInfo
+	var v struct {
+		serverInfo
+		SSHd json.RawMessage `json:"sshd"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*i = ServerInfo(v.serverInfo)
+	i.HasSSHd = len(v.SSHd) > 0 && string(v.SSHd) != "null"
+	return nil
 }
 
 // GetServerInfo returns the information about the Gerrit server configuration.
@@ -125,3 +163,144 @@ func (s *ConfigService) GetServerInfo(ctx context.Context) (*ServerInfo, error)
 
 	return &reply, nil
 }
+
+// GetDefaultPreferences returns the default user preferences for the server.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#get-default-user-preferences
+func (s *ConfigService) GetDefaultPreferences(ctx context.Context) (*PreferencesInfo, error) {
+	u := "config/server/preferences"
+
+	var reply PreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// SetDefaultPreferences sets the default user preferences for the server and returns the resulting preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#set-default-user-preferences
+func (s *ConfigService) SetDefaultPreferences(ctx context.Context, input *PreferencesInput) (*PreferencesInfo, error) {
+	u := "config/server/preferences"
+
+	var reply PreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// GetDefaultDiffPreferences returns the default diff preferences for the server.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#get-default-diff-preferences
+func (s *ConfigService) GetDefaultDiffPreferences(ctx context.Context) (*DiffPreferencesInfo, error) {
+	u := "config/server/preferences.diff"
+
+	var reply DiffPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// SetDefaultDiffPreferences sets the default diff preferences for the server and returns the resulting preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#set-default-diff-preferences
+func (s *ConfigService) SetDefaultDiffPreferences(ctx context.Context, input *DiffPreferencesInput) (*DiffPreferencesInfo, error) {
+	u := "config/server/preferences.diff"
+
+	var reply DiffPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// GetDefaultEditPreferences returns the default edit preferences for the server.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#get-default-edit-preferences
+func (s *ConfigService) GetDefaultEditPreferences(ctx context.Context) (*EditPreferencesInfo, error) {
+	u := "config/server/preferences.edit"
+
+	var reply EditPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// SetDefaultEditPreferences sets the default edit preferences for the server and returns the resulting preferences.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#set-default-edit-preferences
+func (s *ConfigService) SetDefaultEditPreferences(ctx context.Context, input *EditPreferencesInput) (*EditPreferencesInfo, error) {
+	u := "config/server/preferences.edit"
+
+	var reply EditPreferencesInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// CapabilityInfo entity contains information about a global capability.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#capability-info
+type CapabilityInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListCapabilities lists the capabilities that are available in the system, keyed by capability ID.
+// Capabilities of plugins are prefixed with the plugin name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#list-capabilities
+func (s *ConfigService) ListCapabilities(ctx context.Context) (map[string]*CapabilityInfo, error) {
+	u := "config/server/capabilities"
+
+	var reply map[string]*CapabilityInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// ConfigUpdateEntryInfo entity contains information about a single config key that was updated.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#config-update-entry-info
+type ConfigUpdateEntryInfo struct {
+	ConfigKey string `json:"config_key"`
+	OldValue  string `json:"old_value"`
+	NewValue  string `json:"new_value"`
+}
+
+// ConfigUpdateInfo entity contains the result of a config reload.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#config-update-info
+type ConfigUpdateInfo struct {
+	// Applied lists the config updates that were applied.
+	Applied []ConfigUpdateEntryInfo `json:"applied"`
+	// Rejected lists the config updates that require a restart to take effect.
+	Rejected []ConfigUpdateEntryInfo `json:"rejected"`
+}
+
+// ReloadConfig reloads the gerrit.config configuration.
+// Not all configuration values can be picked up by a reload, those are reported as rejected.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#reload-config
+func (s *ConfigService) ReloadConfig(ctx context.Context) (*ConfigUpdateInfo, error) {
+	u := "config/server/reload"
+
+	var reply ConfigUpdateInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, nil, &reply, DelContentType()); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
@@ -0,0 +1,109 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CacheInfo entity contains information about a cache.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#cache-info
+type CacheInfo struct {
+	// Name is the cache name, prefixed with the plugin name for caches of plugins.
+	Name string `json:"name,omitempty"`
+	// Type is MEM for in-memory caches and DISK for disk caches.
+	Type       string       `json:"type,omitempty"`
+	Entries    EntriesInfo  `json:"entries"`
+	AverageGet string       `json:"average_get,omitempty"`
+	HitRatio   HitRatioInfo `json:"hit_ratio"`
+}
+
+// EntriesInfo entity contains information about the entries in a cache.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#entries-info
+type EntriesInfo struct {
+	Mem  int64 `json:"mem,omitempty"`
+	Disk int64 `json:"disk,omitempty"`
+	// Space is the disk space the cache uses, as human readable string.
+	Space string `json:"space,omitempty"`
+}
+
+// HitRatioInfo entity contains information about the hit ratio of a cache in percent.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#hit-ration-info
+type HitRatioInfo struct {
+	Mem  int `json:"mem"`
+	Disk int `json:"disk,omitempty"`
+}
+
+// CacheOperationInput entity contains information about an operation that should be executed on caches.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#cache-operation-input
+type CacheOperationInput struct {
+	// Operation is FLUSH_ALL or FLUSH.
+	Operation string `json:"operation"`
+	// Caches are the names of the caches to flush, only for the FLUSH operation.
+	Caches []string `json:"caches,omitempty"`
+}
+
+// ListCaches lists the caches of the server, keyed by cache name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#list-caches
+func (s *ConfigService) ListCaches(ctx context.Context) (map[string]*CacheInfo, error) {
+	u := "config/server/caches/"
+
+	var reply map[string]*CacheInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// GetCache retrieves information about a cache.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#get-cache
+func (s *ConfigService) GetCache(ctx context.Context, name string) (*CacheInfo, error) {
+	u := fmt.Sprintf("config/server/caches/%s", name)
+
+	var reply CacheInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// FlushCache flushes a cache.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#flush-cache
+func (s *ConfigService) FlushCache(ctx context.Context, name string) error {
+	u := fmt.Sprintf("config/server/caches/%s/flush", name)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FlushCaches flushes several caches in a single request.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#cache-operations
+func (s *ConfigService) FlushCaches(ctx context.Context, names ...string) error {
+	return s.cacheOperation(ctx, &CacheOperationInput{Operation: "FLUSH", Caches: names})
+}
+
+// FlushAllCaches flushes all caches, except the web_sessions cache.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#cache-operations
+func (s *ConfigService) FlushAllCaches(ctx context.Context) error {
+	return s.cacheOperation(ctx, &CacheOperationInput{Operation: "FLUSH_ALL"})
+}
+
+func (s *ConfigService) cacheOperation(ctx context.Context, input *CacheOperationInput) error {
+	u := "config/server/caches/"
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestConfigService_ListCaches(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.ListCaches(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, cache := range reply {
+		t.Logf("cache %s: %+v", name, cache.Entries)
+	}
+}
+
+func TestConfigService_GetCache(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetCache(context.Background(), "project_list")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("cache: %+v", reply)
+}
+
+func TestConfigService_FlushCache(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Config.FlushCache(context.Background(), "project_list")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("flush cache ok!")
+}
+
+func TestConfigService_FlushCaches(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Config.FlushCaches(context.Background(), "project_list", "accounts")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("flush caches ok!")
+}
+
+func TestConfigService_FlushAllCaches(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Config.FlushAllCaches(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("flush all caches ok!")
+}
@@ -0,0 +1,50 @@
+package gerrit
+
+import (
+	"context"
+)
+
+// ServerFeatures reports which optional features a server has, derived from its ServerInfo and version.
+type ServerFeatures struct {
+	Version Version
+
+	// AttentionSet reports whether the attention set is enabled.
+	AttentionSet bool
+	// RobotComments reports whether robot comments are enabled.
+	// Servers that predate the change.enableRobotComments option do not report it and are treated as disabled.
+	RobotComments bool
+	// SubmitRequirements reports whether submit requirements are supported, which needs Gerrit 3.5 or later.
+	SubmitRequirements bool
+	// SSH reports whether the SSH daemon is enabled.
+	SSH bool
+	// Avatars reports whether an avatar provider is registered.
+	Avatars bool
+	// SignedPush reports whether signed push is enabled.
+	SignedPush bool
+}
+
+// NewServerFeatures derives the features of a server from its ServerInfo and version.
+func NewServerFeatures(info *ServerInfo, version Version) *ServerFeatures {
+	return &ServerFeatures{
+		Version:            version,
+		AttentionSet:       info.Change.EnableAttentionSet,
+		RobotComments:      info.Change.EnableRobotComments,
+		SubmitRequirements: version.AtLeast(3, 5),
+		SSH:                info.HasSSHd,
+		Avatars:            info.Plugin.HasAvatars,
+		SignedPush:         info.Receive.EnableSignedPush,
+	}
+}
+
+// GetServerFeatures returns the features of the server from its ServerInfo and version.
+func (s *ConfigService) GetServerFeatures(ctx context.Context) (*ServerFeatures, error) {
+	info, err := s.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.GetServerVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewServerFeatures(info, version), nil
+}
@@ -0,0 +1,56 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testServerInfo = `{
+  "accounts": {"visibility": "ALL", "default_display_name": "FULL_NAME"},
+  "auth": {"type": "LDAP", "editable_account_fields": ["FULL_NAME"]},
+  "change": {"large_change": 500, "update_delay": 300, "mergeability_computation_behavior": "NEVER", "enable_attention_set": true},
+  "plugin": {"has_avatars": true, "js_resource_paths": ["plugins/gitiles/static/gitiles.js"]},
+  "receive": {},
+  "sshd": {},
+  "suggest": {"from": 0},
+  "user": {"anonymous_coward_name": "Name of user not set"}
+}`
+
+func TestServerFeatures(t *testing.T) {
+	var info ServerInfo
+	if err := json.Unmarshal([]byte(testServerInfo), &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.Accounts.DefaultDisplayName != "FULL_NAME" || info.Change.MergeabilityComputationBehavior != "NEVER" ||
+		len(info.Plugin.JsResourcePaths) != 1 {
+		t.Errorf("server info: got %+v", info)
+	}
+
+	version, err := ParseVersion("3.9.1-12-gabcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := *NewServerFeatures(&info, version)
+	want := ServerFeatures{
+		Version:            version,
+		AttentionSet:       true,
+		SubmitRequirements: true,
+		SSH:                true,
+		Avatars:            true,
+	}
+	if got != want {
+		t.Errorf("\ngot:  %+v\nwant: %+v", got, want)
+	}
+
+	info = ServerInfo{}
+	if err := json.Unmarshal([]byte(`{"auth": {"type": "LDAP"}}`), &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.HasSSHd || info.Auth.Type != "LDAP" {
+		t.Errorf("server info without sshd: got %+v", info)
+	}
+	old := NewServerFeatures(&info, Version{Major: 3, Minor: 4, Patch: 8})
+	if old.SubmitRequirements || old.SSH {
+		t.Errorf("old server: got %+v", old)
+	}
+}
@@ -0,0 +1,184 @@
+package gerrit
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHDaemonInfo is the address of the SSH daemon of the server.
+type SSHDaemonInfo struct {
+	Host string
+	Port int
+}
+
+// Addr returns the address in host:port form.
+func (i *SSHDaemonInfo) Addr() string {
+	return net.JoinHostPort(i.Host, strconv.Itoa(i.Port))
+}
+
+// ErrSSHNotAvailable is returned if the SSH daemon of the server is disabled.
+var ErrSSHNotAvailable = errors.New("ssh daemon not available")
+
+// GetSSHInfo returns the address of the SSH daemon as advertised by the server.
+// A wildcard host is replaced with the host of the client endpoint.
+func (s *ConfigService) GetSSHInfo(ctx context.Context) (*SSHDaemonInfo, error) {
+	var reply string
+	if _, err := s.client.Invoke(ctx, http.MethodGet, "/ssh_info", nil, &reply); err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(reply)
+	if len(fields) == 0 || fields[0] == "NOT_AVAILABLE" {
+		return nil, ErrSSHNotAvailable
+	}
+	info := &SSHDaemonInfo{Host: fields[0], Port: 22}
+	if len(fields) > 1 {
+		port, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ssh info %q", reply)
+		}
+		info.Port = port
+	}
+	if info.Host == "*" || info.Host == "0.0.0.0" || info.Host == "::" {
+		if s.client.credential != nil {
+			if u, err := url.Parse(s.client.credential.GetEndpoint()); err == nil && u.Hostname() != "" {
+				info.Host = u.Hostname()
+			}
+		}
+	}
+	return info, nil
+}
+
+// SSHHostKeyInfo contains a public host key of the SSH daemon.
+type SSHHostKeyInfo struct {
+	Host string
+	Port int
+	// Algorithm is the key type, e.g. ssh-ed25519 or ssh-rsa.
+	Algorithm string
+	// EncodedKey is the base64 encoded public key.
+	EncodedKey string
+	// Fingerprint is the SHA256 fingerprint of the key as printed by ssh-keygen -l.
+	Fingerprint string
+}
+
+// KnownHostsLine returns the key as a line for an OpenSSH known_hosts file.
+func (k *SSHHostKeyInfo) KnownHostsLine() string {
+	host := k.Host
+	if k.Port != 22 {
+		host = fmt.Sprintf("[%s]:%d", k.Host, k.Port)
+	}
+	return host + " " + k.Algorithm + " " + k.EncodedKey
+}
+
+// sshHostKeyAlgorithms are the host key algorithms probed by GetSSHHostKeys, in order of preference.
+// rsa-sha2-512 and ssh-rsa both yield the RSA key, the latter only for servers without SHA-2 signatures.
+var sshHostKeyAlgorithms = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoRSASHA512,
+	ssh.KeyAlgoRSA,
+}
+
+// errSSHHostKeyCaptured aborts a handshake once the host key has been received.
+var errSSHHostKeyCaptured = errors.New("ssh host key captured")
+
+// GetSSHHostKeys returns the public host keys of the SSH daemon, one per key type.
+//
+// The REST API does not expose host keys, so they are captured from SSH handshakes with the address
+// returned by GetSSHInfo, as ssh-keyscan does. The keys are NOT verified: anyone answering on that
+// address can present any key, so compare the fingerprints with a trusted source before relying on them.
+func (s *ConfigService) GetSSHHostKeys(ctx context.Context) ([]*SSHHostKeyInfo, error) {
+	info, err := s.GetSSHInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scanSSHHostKeys(ctx, info)
+}
+
+func scanSSHHostKeys(ctx context.Context, info *SSHDaemonInfo) ([]*SSHHostKeyInfo, error) {
+	var (
+		reply    []*SSHHostKeyInfo
+		firstErr error
+	)
+	seen := make(map[string]bool)
+	for _, alg := range sshHostKeyAlgorithms {
+		if alg == ssh.KeyAlgoRSA && seen[ssh.KeyAlgoRSA] {
+			continue
+		}
+		key, err := scanSSHHostKey(ctx, info.Addr(), alg)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if seen[key.Type()] {
+			continue
+		}
+		seen[key.Type()] = true
+		reply = append(reply, &SSHHostKeyInfo{
+			Host:        info.Host,
+			Port:        info.Port,
+			Algorithm:   key.Type(),
+			EncodedKey:  base64.StdEncoding.EncodeToString(key.Marshal()),
+			Fingerprint: ssh.FingerprintSHA256(key),
+		})
+	}
+	if len(reply) == 0 {
+		return nil, fmt.Errorf("scan ssh host keys of %s: %w", info.Addr(), firstErr)
+	}
+	return reply, nil
+}
+
+// scanSSHHostKey runs an SSH handshake offering only the given host key algorithm,
+// and returns the host key presented by the server without authenticating.
+func scanSSHHostKey(ctx context.Context, addr, algorithm string) (ssh.PublicKey, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(30 * time.Second)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	var key ssh.PublicKey
+	_, _, _, err = ssh.NewClientConn(conn, addr, &ssh.ClientConfig{
+		User:              "go-gerrit",
+		HostKeyAlgorithms: []string{algorithm},
+		HostKeyCallback: func(_ string, _ net.Addr, k ssh.PublicKey) error {
+			key = k
+			return errSSHHostKeyCaptured
+		},
+	})
+	if key == nil {
+		if err == nil {
+			err = errors.New("no host key received")
+		}
+		return nil, err
+	}
+	return key, nil
+}
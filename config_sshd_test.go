@@ -0,0 +1,110 @@
+package gerrit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// startSSHServer serves SSH handshakes with the given host keys until the test ends.
+func startSSHServer(t *testing.T, signers ...ssh.Signer) *net.TCPAddr {
+	t.Helper()
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	for _, signer := range signers {
+		config.AddHostKey(signer)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _, _, _ = ssh.NewServerConn(conn, config)
+			}()
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr)
+}
+
+func TestGetSSHHostKeys(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var signers []ssh.Signer
+	for _, key := range []any{edKey, ecKey} {
+		signer, err := ssh.NewSignerFromKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers = append(signers, signer)
+	}
+	addr := startSSHServer(t, signers...)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ssh_info" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprintf(w, "127.0.0.1 %d", addr.Port)
+	}))
+	defer srv.Close()
+
+	client := NewClient(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+	keys, err := client.Config.GetSSHHostKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != len(signers) {
+		t.Fatalf("got %d keys, want %d", len(keys), len(signers))
+	}
+	for i, signer := range signers {
+		want := signer.PublicKey()
+		got := keys[i]
+		if got.Algorithm != want.Type() {
+			t.Errorf("key %d: algorithm %q, want %q", i, got.Algorithm, want.Type())
+		}
+		if got.Fingerprint != ssh.FingerprintSHA256(want) {
+			t.Errorf("key %d: fingerprint %q, want %q", i, got.Fingerprint, ssh.FingerprintSHA256(want))
+		}
+		line := fmt.Sprintf("[127.0.0.1]:%d %s", addr.Port, string(ssh.MarshalAuthorizedKey(want)))
+		if got.KnownHostsLine()+"\n" != line {
+			t.Errorf("key %d: known_hosts line %q, want %q", i, got.KnownHostsLine(), line)
+		}
+	}
+}
+
+func TestGetSSHHostKeysUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().(*net.TCPAddr)
+	_ = ln.Close()
+
+	_, err = scanSSHHostKeys(context.Background(), &SSHDaemonInfo{Host: "127.0.0.1", Port: addr.Port})
+	if err == nil {
+		t.Fatal("expected an error for a closed port")
+	}
+}
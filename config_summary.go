@@ -0,0 +1,267 @@
+package gerrit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ByteSize is an amount of memory in bytes.
+// It decodes from the human readable sizes of the server summary, like "1.33g".
+type ByteSize int64
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *ByteSize) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n int64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("invalid byte size %s", data)
+		}
+		*b = ByteSize(n)
+		return nil
+	}
+	v, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// String formats the size the way the server summary does.
+func (b ByteSize) String() string {
+	v := float64(b) / 1024
+	suffix := "k"
+	if v > 1024 || v < -1024 {
+		v, suffix = v/1024, "m"
+	}
+	if v > 1024 || v < -1024 {
+		v, suffix = v/1024, "g"
+	}
+	return strconv.FormatFloat(v, 'f', 2, 64) + suffix
+}
+
+// ParseByteSize parses a size with an optional k, m or g suffix, as reported by the server summary.
+func ParseByteSize(s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	mult := 1.0
+	if n := len(s); n > 0 {
+		switch s[n-1] {
+		case 'k', 'K':
+			mult = 1 << 10
+		case 'm', 'M':
+			mult = 1 << 20
+		case 'g', 'G':
+			mult = 1 << 30
+		case 't', 'T':
+			mult = 1 << 40
+		}
+		if mult != 1 {
+			s = strings.TrimSpace(s[:n-1])
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+	return ByteSize(v * mult), nil
+}
+
+// TaskSummaryInfo entity contains information about current tasks.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#task-summary-info
+type TaskSummaryInfo struct {
+	Total    int `json:"total,omitempty"`
+	Running  int `json:"running,omitempty"`
+	Ready    int `json:"ready,omitempty"`
+	Sleeping int `json:"sleeping,omitempty"`
+}
+
+// MemSummaryInfo entity contains information about the current memory usage of the JVM.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#mem-summary-info
+type MemSummaryInfo struct {
+	Total     ByteSize `json:"total"`
+	Used      ByteSize `json:"used"`
+	Free      ByteSize `json:"free"`
+	Buffers   ByteSize `json:"buffers"`
+	Max       ByteSize `json:"max"`
+	OpenFiles int      `json:"open_files,omitempty"`
+}
+
+// ThreadSummaryInfo entity contains information about the current threads.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#thread-summary-info
+type ThreadSummaryInfo struct {
+	CPUs    int `json:"cpus"`
+	Threads int `json:"threads"`
+	// Counts maps thread prefixes to the number of threads per thread state.
+	Counts map[string]map[string]int `json:"counts"`
+}
+
+// JvmSummaryInfo entity contains information about the JVM.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#jvm-summary-info
+type JvmSummaryInfo struct {
+	VMVendor                string `json:"vm_vendor"`
+	VMName                  string `json:"vm_name"`
+	VMVersion               string `json:"vm_version"`
+	OSName                  string `json:"os_name"`
+	OSVersion               string `json:"os_version"`
+	OSArch                  string `json:"os_arch"`
+	User                    string `json:"user"`
+	Host                    string `json:"host,omitempty"`
+	CurrentWorkingDirectory string `json:"current_working_directory"`
+	Site                    string `json:"site"`
+}
+
+// SummaryInfo entity contains information about the current state of the server.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#summary-info
+type SummaryInfo struct {
+	TaskSummary   TaskSummaryInfo   `json:"task_summary"`
+	MemSummary    MemSummaryInfo    `json:"mem_summary"`
+	ThreadSummary ThreadSummaryInfo `json:"thread_summary"`
+	// JvmSummary is only set if the jvm option was requested.
+	JvmSummary *JvmSummaryInfo `json:"jvm_summary,omitempty"`
+}
+
+// GetSummaryOptions specifies the different options for the GetSummary call.
+type GetSummaryOptions struct {
+	// Include a summary about the JVM.
+	JVM *bool `query:"jvm,omitempty"`
+	// Run the Java garbage collector before the memory is summarized.
+	GC *bool `query:"gc,omitempty"`
+}
+
+// GetSummary retrieves a summary of the current server state.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#get-summary
+func (s *ConfigService) GetSummary(ctx context.Context, opts *GetSummaryOptions) (*SummaryInfo, error) {
+	u := "config/server/summary"
+
+	var reply SummaryInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, opts, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// SummarySample is a server summary taken at a point in time.
+type SummarySample struct {
+	Time    time.Time
+	Summary *SummaryInfo
+}
+
+// SummaryDelta is the change between two summary samples.
+type SummaryDelta struct {
+	Interval time.Duration
+
+	Tasks TaskSummaryInfo
+
+	UsedMemory ByteSize
+	FreeMemory ByteSize
+	OpenFiles  int
+
+	Threads int
+}
+
+// DiffSummary returns the change from sample prev to sample cur.
+func DiffSummary(prev, cur *SummarySample) *SummaryDelta {
+	p, c := prev.Summary, cur.Summary
+	return &SummaryDelta{
+		Interval: cur.Time.Sub(prev.Time),
+		Tasks: TaskSummaryInfo{
+			Total:    c.TaskSummary.Total - p.TaskSummary.Total,
+			Running:  c.TaskSummary.Running - p.TaskSummary.Running,
+			Ready:    c.TaskSummary.Ready - p.TaskSummary.Ready,
+			Sleeping: c.TaskSummary.Sleeping - p.TaskSummary.Sleeping,
+		},
+		UsedMemory: c.MemSummary.Used - p.MemSummary.Used,
+		FreeMemory: c.MemSummary.Free - p.MemSummary.Free,
+		OpenFiles:  c.MemSummary.OpenFiles - p.MemSummary.OpenFiles,
+		Threads:    c.ThreadSummary.Threads - p.ThreadSummary.Threads,
+	}
+}
+
+// SummaryPoller samples the server summary at a fixed interval and keeps the change between the last two samples.
+// It is safe for concurrent use.
+type SummaryPoller struct {
+	config   *ConfigService
+	interval time.Duration
+	opts     *GetSummaryOptions
+
+	mu    sync.Mutex
+	last  *SummarySample
+	delta *SummaryDelta
+}
+
+// DefaultSummaryPollInterval is the interval of a SummaryPoller created with a non-positive interval.
+const DefaultSummaryPollInterval = time.Minute
+
+// NewSummaryPoller returns a poller that calls GetSummary with opts every interval,
+// or every DefaultSummaryPollInterval if interval is not positive.
+func (s *ConfigService) NewSummaryPoller(interval time.Duration, opts *GetSummaryOptions) *SummaryPoller {
+	if interval <= 0 {
+		interval = DefaultSummaryPollInterval
+	}
+	return &SummaryPoller{
+		config:   s,
+		interval: interval,
+		opts:     opts,
+	}
+}
+
+// Poll takes a single sample and returns it with the change since the previous sample,
+// which is nil for the first sample.
+func (p *SummaryPoller) Poll(ctx context.Context) (*SummarySample, *SummaryDelta, error) {
+	summary, err := p.config.GetSummary(ctx, p.opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	sample := &SummarySample{Time: time.Now(), Summary: summary}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var delta *SummaryDelta
+	if p.last != nil {
+		delta = DiffSummary(p.last, sample)
+	}
+	p.last, p.delta = sample, delta
+	return sample, delta, nil
+}
+
+// Latest returns the last sample and its change since the sample before, nil if not available yet.
+func (p *SummaryPoller) Latest() (*SummarySample, *SummaryDelta) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.last, p.delta
+}
+
+// Run polls immediately and then every interval until ctx is done, and returns the context error.
+// fn, if not nil, is called after every poll; failed polls are reported to fn and do not stop the poller.
+func (p *SummaryPoller) Run(ctx context.Context, fn func(sample *SummarySample, delta *SummaryDelta, err error)) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		sample, delta, err := p.Poll(ctx)
+		if fn != nil {
+			fn(sample, delta, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
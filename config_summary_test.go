@@ -0,0 +1,65 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const testSummary = `{
+  "task_summary": {"total": 2, "sleeping": 2},
+  "mem_summary": {"total": "1.33g", "used": "512.00m", "free": "836.50m", "buffers": "0.00k", "max": "3.56g", "open_files": 120},
+  "thread_summary": {"cpus": 8, "threads": 44, "counts": {"HTTP": {"RUNNABLE": 3, "TIMED_WAITING": 2}}}
+}`
+
+func TestSummaryDelta(t *testing.T) {
+	var prev SummaryInfo
+	if err := json.Unmarshal([]byte(testSummary), &prev); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := prev.MemSummary.Used, ByteSize(512<<20); got != want {
+		t.Errorf("used: got %d, want %d", got, want)
+	}
+	if got := prev.MemSummary.Total.String(); got != "1.33g" {
+		t.Errorf("total: got %q", got)
+	}
+	if got := prev.ThreadSummary.Counts["HTTP"]["RUNNABLE"]; got != 3 {
+		t.Errorf("threads: got %d", got)
+	}
+
+	cur := prev
+	cur.TaskSummary = TaskSummaryInfo{Total: 7, Running: 1, Ready: 4, Sleeping: 2}
+	cur.MemSummary.Used += 256 << 20
+	cur.ThreadSummary.Threads = 40
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	delta := DiffSummary(
+		&SummarySample{Time: start, Summary: &prev},
+		&SummarySample{Time: start.Add(time.Minute), Summary: &cur},
+	)
+	want := SummaryDelta{
+		Interval:   time.Minute,
+		Tasks:      TaskSummaryInfo{Total: 5, Running: 1, Ready: 4},
+		UsedMemory: 256 << 20,
+		Threads:    -4,
+	}
+	if *delta != want {
+		t.Errorf("\ngot:  %+v\nwant: %+v", *delta, want)
+	}
+
+	if _, err := ParseByteSize("12x"); err == nil {
+		t.Error("expected an error for an invalid size")
+	}
+}
+
+func TestNewSummaryPollerInterval(t *testing.T) {
+	var s ConfigService
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if p := s.NewSummaryPoller(interval, nil); p.interval != DefaultSummaryPollInterval {
+			t.Errorf("interval %v: got %v, want %v", interval, p.interval, DefaultSummaryPollInterval)
+		}
+	}
+	if p := s.NewSummaryPoller(time.Second, nil); p.interval != time.Second {
+		t.Errorf("interval: got %v, want %v", p.interval, time.Second)
+	}
+}
@@ -0,0 +1,62 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TaskInfo entity contains information about a task in a background work queue.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#task-info
+type TaskInfo struct {
+	ID string `json:"id"`
+	// State is one of DONE, CANCELLED, RUNNING, READY, SLEEPING or OTHER.
+	State     string     `json:"state"`
+	StartTime *Timestamp `json:"start_time"`
+	// Delay is the remaining delay of the task in milliseconds.
+	Delay      int64  `json:"delay"`
+	Command    string `json:"command"`
+	RemoteName string `json:"remote_name,omitempty"`
+	Project    string `json:"project,omitempty"`
+	QueueName  string `json:"queue_name"`
+}
+
+// ListTasks lists the tasks from the background work queues that the Gerrit daemon is currently performing, or will perform in the near future.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#list-tasks
+func (s *ConfigService) ListTasks(ctx context.Context) ([]*TaskInfo, error) {
+	u := "config/server/tasks/"
+
+	var reply []*TaskInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// GetTask retrieves a task from the background work queue.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#get-task
+func (s *ConfigService) GetTask(ctx context.Context, taskID string) (*TaskInfo, error) {
+	u := fmt.Sprintf("config/server/tasks/%s", taskID)
+
+	var reply TaskInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// DeleteTask kills a task from the background work queue.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-config.html#delete-task
+func (s *ConfigService) DeleteTask(ctx context.Context, taskID string) error {
+	u := fmt.Sprintf("config/server/tasks/%s", taskID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
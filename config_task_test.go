@@ -0,0 +1,52 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestConfigService_ListTasks(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.ListTasks(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, task := range reply {
+		t.Logf("task %s [%s] %s: %s", task.ID, task.QueueName, task.State, task.Command)
+	}
+}
+
+func TestConfigService_GetTask(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetTask(context.Background(), "1e688bea")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("task: %+v", reply)
+}
+
+func TestConfigService_DeleteTask(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Config.DeleteTask(context.Background(), "1e688bea")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete task ok!")
+}
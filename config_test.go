@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
 )
 
 func TestConfigService_GetVersion(t *testing.T) {
@@ -34,3 +35,197 @@ func TestConfigService_GetServerInfo(t *testing.T) {
 
 	t.Logf("info: %+v", reply)
 }
+
+func TestConfigService_GetDefaultPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetDefaultPreferences(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestConfigService_SetDefaultPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.SetDefaultPreferences(context.Background(), &gerrit.PreferencesInput{
+		EmailStrategy: ptr.Ptr(gerrit.EmailCCOnOwnComments),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestConfigService_GetDefaultDiffPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetDefaultDiffPreferences(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestConfigService_SetDefaultDiffPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.SetDefaultDiffPreferences(context.Background(), &gerrit.DiffPreferencesInput{
+		Context: ptr.Ptr(10),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestConfigService_GetDefaultEditPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetDefaultEditPreferences(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestConfigService_SetDefaultEditPreferences(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.SetDefaultEditPreferences(context.Background(), &gerrit.EditPreferencesInput{
+		LineLength: ptr.Ptr(100),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("preferences: %+v", reply)
+}
+
+func TestConfigService_ListCapabilities(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.ListCapabilities(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id, c := range reply {
+		t.Logf("capability %s: %s", id, c.Name)
+	}
+}
+
+func TestConfigService_ReloadConfig(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.ReloadConfig(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("applied: %+v, rejected: %+v", reply.Applied, reply.Rejected)
+}
+
+func TestConfigService_GetSummary(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetSummary(context.Background(), &gerrit.GetSummaryOptions{
+		JVM: ptr.Ptr(true),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("tasks: %+v, memory used: %s of %s", reply.TaskSummary, reply.MemSummary.Used, reply.MemSummary.Max)
+}
+
+func TestConfigService_GetServerFeatures(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetServerFeatures(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("features: %+v", reply)
+}
+
+func TestConfigService_GetSSHInfo(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetSSHInfo(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("ssh daemon: %s", reply.Addr())
+}
+
+func TestConfigService_GetServerVersion(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetServerVersion(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("version: %s, labels API: %v", reply, reply.AtLeast(3, 6))
+}
+
+func TestConfigService_GetSSHHostKeys(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Config.GetSSHHostKeys(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range reply {
+		t.Logf("host key %s %s", key.Algorithm, key.Fingerprint)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nexuer/ghttp"
@@ -28,10 +29,15 @@ type Options struct {
 }
 
 type Client struct {
-	cc *ghttp.Client
+	cc   *ghttp.Client
+	opts *Options
 
 	credential Credential
 
+	// version caches the lookup of the server version, see ConfigService.GetServerVersion.
+	versionMu sync.Mutex
+	version   *versionCall
+
 	common   service
 	Accounts *AccountsService
 	Changes  *ChangesService
@@ -42,6 +48,9 @@ type Client struct {
 
 func NewClient(credential Credential, opts ...*Options) *Client {
 	c := &Client{}
+	if len(opts) > 0 {
+		c.opts = opts[0]
+	}
 
 	clientOpts := c.parseOptions(opts...)
 	clientOpts = append(clientOpts,
@@ -106,6 +115,15 @@ func (c *Client) SetCredential(credential Credential) {
 		c.cc.SetEndpoint(endpoint)
 	}
 	c.credential = credential
+
+	c.versionMu.Lock()
+	c.version = nil
+	c.versionMu.Unlock()
+}
+
+// withCredential returns a new client with the same options, authenticated with another credential.
+func (c *Client) withCredential(credential Credential) *Client {
+	return NewClient(credential, c.opts)
 }
 
 var magicPrefix = []byte(")]}'\n")
@@ -148,6 +166,38 @@ func (c *Client) Invoke(ctx context.Context, method, path string, args any, repl
 	return c.cc.Invoke(ctx, method, path, args, reply, opts)
 }
 
+// bindOptionalBody decodes the body of a response into reply unless it is empty,
+// as for endpoints that answer with either the new value or 204 No Content.
+func bindOptionalBody(response *http.Response, reply any) error {
+	if response.StatusCode == http.StatusNoContent || response.Body == nil {
+		return nil
+	}
+	all, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+	if len(bytes.TrimSpace(all)) == 0 {
+		return nil
+	}
+	response.Body = io.NopCloser(bytes.NewReader(all))
+	return ghttp.BindResponseBody(response, reply)
+}
+
+// putString sends a PUT request to an endpoint that answers with the new value as JSON string,
+// or with 204 No Content if the value was deleted.
+func (c *Client) putString(ctx context.Context, u string, input any) (string, error) {
+	resp, err := c.InvokeWithCredential(ctx, http.MethodPut, u, input, nil)
+	if err != nil {
+		return "", err
+	}
+	var reply string
+	if err := bindOptionalBody(resp, &reply); err != nil {
+		return "", err
+	}
+	return reply, nil
+}
+
 func DelContentType() ghttp.RequestFunc {
 	return func(req *http.Request) error {
 		req.Header.Del("Content-Type")
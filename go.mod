@@ -5,9 +5,11 @@ go 1.21
 require (
 	github.com/nexuer/ghttp v0.0.0-20250116065619-72be5fead3cd
 	github.com/nexuer/utils v0.0.0-20250116055402-1c8b5ff8540c
+	golang.org/x/crypto v0.33.0
 )
 
 require (
+	golang.org/x/sys v0.30.0 // indirect
 	google.golang.org/protobuf v1.36.3 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
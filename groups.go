@@ -2,11 +2,15 @@ package gerrit
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
 // GroupsService contains Group related REST endpoints
 //
+// Group IDs, names and UUIDs are put into request paths as is, so they must be URL encoded by the caller,
+// e.g. with url.PathEscape. The IDs of GroupInfo are URL encoded already and can be passed unchanged.
+//
 // Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html
 type GroupsService service
 
@@ -43,7 +47,7 @@ type GroupInfo struct {
 //
 // Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#list-groups
 type ListGroupsOptions struct {
-	ListOptions
+	ListOptions `query:",inline,omitempty"`
 
 	// Group Options
 	// Options fields can be obtained by adding o parameters, each option requires more lookups and slows down the query response time to the client so they are generally disabled by default.
@@ -51,6 +55,29 @@ type ListGroupsOptions struct {
 	//	INCLUDES: include list of directly included groups.
 	//	MEMBERS: include list of direct group members.
 	AdditionalFields []GroupAdditionalField `query:"o,omitempty"`
+
+	// Limit the results to the groups that are owned by the calling user.
+	Owned *bool `query:"owned,omitempty"`
+
+	// Limit the results to the groups that are visible to all registered users.
+	VisibleToAll *bool `query:"visible-to-all,omitempty"`
+
+	// Limit the results to those groups that match the specified regex.
+	// Boundary matchers '^' and '$' are implicit.
+	Regex *string `query:"r,omitempty"`
+
+	// Limit the results to those groups that match the specified substring, case-insensitive.
+	Substring *string `query:"m,omitempty"`
+
+	// Limit the results to the groups that have a permission on the specified project.
+	Project *string `query:"p,omitempty"`
+
+	// Limit the results to the groups that the specified user is a member of.
+	User *string `query:"user,omitempty"`
+
+	// Get groups that start with the specified prefix, for auto-completion.
+	// The prefix is case-insensitive and the number of results is limited, by default to 10.
+	Suggest *string `query:"suggest,omitempty"`
 }
 
 // ListGroups lists the groups accessible by the caller.
@@ -65,3 +92,120 @@ func (s *GroupsService) ListGroups(ctx context.Context, opts *ListGroupsOptions)
 	}
 	return reply, nil
 }
+
+// GetGroup retrieves a group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#get-group
+func (s *GroupsService) GetGroup(ctx context.Context, groupID string) (*GroupInfo, error) {
+	u := fmt.Sprintf("groups/%s", groupID)
+
+	var reply GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GetGroupDetail retrieves a group with the direct members and the directly included groups.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#get-group-detail
+func (s *GroupsService) GetGroupDetail(ctx context.Context, groupID string) (*GroupInfo, error) {
+	u := fmt.Sprintf("groups/%s/detail", groupID)
+
+	var reply GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GroupInput entity contains information for the creation of a new internal group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#group-input
+type GroupInput struct {
+	// Name of the group, must match the name in the URL if set.
+	Name         *string `json:"name,omitempty"`
+	UUID         *string `json:"uuid,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	VisibleToAll *bool   `json:"visible_to_all,omitempty"`
+	// OwnerID is the URL encoded ID of the owner group, the group itself owns it if not set.
+	OwnerID *string `json:"owner_id,omitempty"`
+	// Members are the account IDs, emails or usernames of the initial members.
+	Members []string `json:"members,omitempty"`
+}
+
+// CreateGroup creates a new Gerrit internal group.
+// name must be URL encoded, like the group IDs of the other methods.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#create-group
+func (s *GroupsService) CreateGroup(ctx context.Context, name string, input *GroupInput) (*GroupInfo, error) {
+	u := fmt.Sprintf("groups/%s", name)
+
+	var reply GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// RenameGroup renames a Gerrit internal group and returns the new name.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#rename-group
+func (s *GroupsService) RenameGroup(ctx context.Context, groupID, name string) (string, error) {
+	u := fmt.Sprintf("groups/%s/name", groupID)
+	return s.client.putString(ctx, u, map[string]string{"name": name})
+}
+
+// SetDescription sets the description of a Gerrit internal group and returns the new description.
+// An empty description deletes it.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#set-group-description
+func (s *GroupsService) SetDescription(ctx context.Context, groupID, description string) (string, error) {
+	u := fmt.Sprintf("groups/%s/description", groupID)
+	return s.client.putString(ctx, u, map[string]string{"description": description})
+}
+
+// DeleteDescription deletes the description of a Gerrit internal group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#delete-group-description
+func (s *GroupsService) DeleteDescription(ctx context.Context, groupID string) error {
+	u := fmt.Sprintf("groups/%s/description", groupID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetOwner sets the owner group of a Gerrit internal group and returns the owner group.
+// owner can be the ID, UUID or name of the owner group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#set-group-owner
+func (s *GroupsService) SetOwner(ctx context.Context, groupID, owner string) (*GroupInfo, error) {
+	u := fmt.Sprintf("groups/%s/owner", groupID)
+
+	var reply GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, map[string]string{"owner": owner}, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GroupOptionsInput entity contains new options for a group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#group-options-input
+type GroupOptionsInput struct {
+	VisibleToAll *bool `json:"visible_to_all,omitempty"`
+}
+
+// SetOptions sets the options of a Gerrit internal group and returns the new options.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#set-group-options
+func (s *GroupsService) SetOptions(ctx context.Context, groupID string, input *GroupOptionsInput) (*GroupOptionsInfo, error) {
+	u := fmt.Sprintf("groups/%s/options", groupID)
+
+	var reply GroupOptionsInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
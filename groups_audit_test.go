@@ -0,0 +1,79 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testGroupAuditLog = `[
+  {
+    "member": {"_account_id": 1000002, "name": "Jane Roe", "email": "jane.roe@example.com", "username": "jroe"},
+    "type": "REMOVE_USER",
+    "user": {"_account_id": 1000000, "name": "Administrator", "username": "admin"},
+    "date": "2023-09-14 09:30:00.000000000"
+  },
+  {
+    "member": {"id": "c3d4", "name": "Contractors"},
+    "type": "ADD_GROUP",
+    "user": {"_account_id": 1000000, "name": "Administrator", "username": "admin"},
+    "date": "2023-07-02 12:00:00.000000000"
+  },
+  {
+    "member": {"_account_id": 1000002, "name": "Jane Roe", "email": "jane.roe@example.com", "username": "jroe"},
+    "type": "ADD_USER",
+    "user": {"_account_id": 1000000, "name": "Administrator", "username": "admin"},
+    "date": "2023-06-30 23:59:59.000000000"
+  }
+]`
+
+func TestGroupAuditExport(t *testing.T) {
+	var events []*GroupAuditEventInfo
+	if err := json.Unmarshal([]byte(testGroupAuditLog), &events); err != nil {
+		t.Fatal(err)
+	}
+	if events[0].MemberAccount == nil || events[0].MemberAccount.Username != "jroe" || events[0].MemberGroup != nil {
+		t.Errorf("user event: got %+v", events[0])
+	}
+	if events[1].MemberGroup == nil || events[1].MemberGroup.ID != "c3d4" || events[1].MemberAccount != nil {
+		t.Errorf("group event: got %+v", events[1])
+	}
+
+	b, err := json.Marshal(events[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTrip GroupAuditEventInfo
+	if err := json.Unmarshal(b, &roundTrip); err != nil || roundTrip.MemberGroup == nil || roundTrip.MemberGroup.Name != "Contractors" {
+		t.Errorf("round trip: got %+v, %v", roundTrip, err)
+	}
+
+	since := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	records := NewGroupAuditRecords("Administrators", events, since, until)
+	if len(records) != 2 {
+		t.Fatalf("records: got %d, want 2", len(records))
+	}
+
+	var csv strings.Builder
+	if err := WriteGroupAuditRecords(&csv, records, GroupAuditCSV); err != nil {
+		t.Fatal(err)
+	}
+	want := `group,date,type,actor_id,actor,member_id,member
+Administrators,2023-09-14T09:30:00Z,REMOVE_USER,1000000,admin,1000002,jroe
+Administrators,2023-07-02T12:00:00Z,ADD_GROUP,1000000,admin,c3d4,Contractors
+`
+	if got := csv.String(); got != want {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, want)
+	}
+
+	var lines strings.Builder
+	if err := WriteGroupAuditRecords(&lines, records[:1], ""); err != nil {
+		t.Fatal(err)
+	}
+	wantLine := `{"group":"Administrators","date":"2023-09-14T09:30:00Z","type":"REMOVE_USER","actor_id":1000000,"actor":"admin","member_id":"1000002","member":"jroe"}` + "\n"
+	if got := lines.String(); got != wantLine {
+		t.Errorf("\ngot:  %v\nwant: %v", got, wantLine)
+	}
+}
@@ -0,0 +1,153 @@
+package gerrit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupsFileName is the name of the file in refs/meta/config mapping group UUIDs to group names.
+const GroupsFileName = "groups"
+
+const groupsFileHeader = "# UUID                                  \tGroup Name\n#"
+
+// GroupReference is a single entry of the groups file.
+type GroupReference struct {
+	UUID string
+	Name string
+}
+
+// GroupsFile is an editable representation of the groups file stored in refs/meta/config next to project.config.
+// It maps the UUIDs of the groups referenced by the access sections to their names.
+//
+// Comments, blank lines and the order of entries are preserved,
+// so a file that is parsed and written back without modifications produces identical output.
+//
+// Gerrit docs: https://gerrit-review.googlesource.com/Documentation/config-project-config.html#file-groups
+type GroupsFile struct {
+	lines []*groupsLine
+
+	noFinalNewline bool
+}
+
+// groupsLine is either a group entry or a comment or blank line.
+// raw holds the original text and is cleared once the entry is modified.
+type groupsLine struct {
+	raw  string
+	uuid string
+	name string
+}
+
+// ParseGroupsFile parses the content of a groups file.
+func ParseGroupsFile(data []byte) (*GroupsFile, error) {
+	f := new(GroupsFile)
+	lines, noFinalNewline := splitLines(string(data))
+	f.noFinalNewline = noFinalNewline
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed[0] == '#' {
+			f.lines = append(f.lines, &groupsLine{raw: line})
+			continue
+		}
+		uuid, name, found := strings.Cut(line, "\t")
+		uuid, name = strings.TrimSpace(uuid), strings.TrimSpace(name)
+		if !found || uuid == "" || name == "" {
+			return nil, fmt.Errorf("groups file: line %d: expected UUID and name separated by a tab", i+1)
+		}
+		f.lines = append(f.lines, &groupsLine{raw: line, uuid: uuid, name: name})
+	}
+	return f, nil
+}
+
+// Groups returns the group entries in file order.
+func (f *GroupsFile) Groups() []GroupReference {
+	var reply []GroupReference
+	for _, l := range f.lines {
+		if l.uuid != "" {
+			reply = append(reply, GroupReference{UUID: l.uuid, Name: l.name})
+		}
+	}
+	return reply
+}
+
+// UUID returns the UUID of the group with the given name.
+func (f *GroupsFile) UUID(name string) (string, bool) {
+	for _, l := range f.lines {
+		if l.uuid != "" && l.name == name {
+			return l.uuid, true
+		}
+	}
+	return "", false
+}
+
+// Name returns the name of the group with the given UUID.
+func (f *GroupsFile) Name(uuid string) (string, bool) {
+	if l := f.find(uuid); l != nil {
+		return l.name, true
+	}
+	return "", false
+}
+
+// Set adds a group or renames an existing one.
+// New groups are inserted before the first entry with a greater UUID, keeping sorted files sorted.
+func (f *GroupsFile) Set(uuid, name string) {
+	if l := f.find(uuid); l != nil {
+		if l.name != name {
+			l.name = name
+			l.raw = ""
+		}
+		return
+	}
+	if len(f.lines) == 0 {
+		for _, h := range strings.Split(groupsFileHeader, "\n") {
+			f.lines = append(f.lines, &groupsLine{raw: h})
+		}
+	}
+	pos := len(f.lines)
+	for i, l := range f.lines {
+		if l.uuid != "" && l.uuid > uuid {
+			pos = i
+			break
+		}
+	}
+	f.lines = append(f.lines, nil)
+	copy(f.lines[pos+1:], f.lines[pos:])
+	f.lines[pos] = &groupsLine{uuid: uuid, name: name}
+}
+
+// Remove removes the group with the given UUID.
+// It reports whether the group existed.
+func (f *GroupsFile) Remove(uuid string) bool {
+	for i, l := range f.lines {
+		if l.uuid == uuid {
+			f.lines = append(f.lines[:i], f.lines[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Bytes returns the content of the file.
+func (f *GroupsFile) Bytes() []byte {
+	return []byte(f.String())
+}
+
+func (f *GroupsFile) String() string {
+	lines := make([]string, 0, len(f.lines))
+	for _, l := range f.lines {
+		if l.raw != "" || l.uuid == "" {
+			lines = append(lines, l.raw)
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%-40s\t%s", l.uuid, l.name))
+	}
+	return joinLines(lines, f.noFinalNewline)
+}
+
+func (f *GroupsFile) find(uuid string) *groupsLine {
+	for _, l := range f.lines {
+		if l.uuid != "" && l.uuid == uuid {
+			return l
+		}
+	}
+	return nil
+}
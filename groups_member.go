@@ -29,3 +29,62 @@ func (s *GroupsService) ListGroupMembers(ctx context.Context, groupID string, op
 
 	return reply, nil
 }
+
+// MembersInput entity contains information about accounts that should be added as members to a group or that should be deleted from the group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#members-input
+type MembersInput struct {
+	// Members are the account IDs, emails or usernames of the accounts.
+	Members []string `json:"members"`
+}
+
+// AddGroupMember adds a user as member to a Gerrit internal group.
+// Adding an account that is already a member succeeds and returns the account.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#add-group-member
+func (s *GroupsService) AddGroupMember(ctx context.Context, groupID, account string) (*AccountInfo, error) {
+	u := fmt.Sprintf("groups/%s/members/%s", groupID, account)
+
+	var reply AccountInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, nil, &reply, DelContentType()); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// AddGroupMembers adds one or several users to a Gerrit internal group in a single request.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#_add_group_members
+func (s *GroupsService) AddGroupMembers(ctx context.Context, groupID string, accounts []string) ([]*AccountInfo, error) {
+	u := fmt.Sprintf("groups/%s/members.add", groupID)
+
+	var reply []*AccountInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, &MembersInput{Members: accounts}, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// RemoveGroupMember removes a user from a Gerrit internal group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#delete-group-member
+func (s *GroupsService) RemoveGroupMember(ctx context.Context, groupID, account string) error {
+	u := fmt.Sprintf("groups/%s/members/%s", groupID, account)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoveGroupMembers removes one or several users from a Gerrit internal group in a single request.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#delete-group-members
+func (s *GroupsService) RemoveGroupMembers(ctx context.Context, groupID string, accounts []string) error {
+	u := fmt.Sprintf("groups/%s/members.delete", groupID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, &MembersInput{Members: accounts}, nil); err != nil {
+		return err
+	}
+	return nil
+}
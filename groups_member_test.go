@@ -19,3 +19,55 @@ func TestGroupsService_ListGroupMembers(t *testing.T) {
 
 	t.Logf("reply: %v", reply)
 }
+
+func TestGroupsService_AddGroupMember(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	reply, err := client.Groups.AddGroupMember(context.Background(), "test-group", "admin")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("member: %+v", reply)
+}
+
+func TestGroupsService_AddGroupMembers(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	reply, err := client.Groups.AddGroupMembers(context.Background(), "test-group", []string{"admin", "test"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("members: %v", len(reply))
+}
+
+func TestGroupsService_RemoveGroupMember(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	err := client.Groups.RemoveGroupMember(context.Background(), "test-group", "test")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("remove member ok!")
+}
+
+func TestGroupsService_RemoveGroupMembers(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	err := client.Groups.RemoveGroupMembers(context.Background(), "test-group", []string{"admin", "test"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("remove members ok!")
+}
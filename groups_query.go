@@ -0,0 +1,38 @@
+package gerrit
+
+import (
+	"context"
+	"net/http"
+)
+
+// QueryGroupsOptions specifies the different options for the QueryGroups call.
+type QueryGroupsOptions struct {
+	ListOptions `query:",inline,omitempty"`
+
+	AdditionalFields []GroupAdditionalField `query:"o,omitempty"`
+}
+
+type queryGroupsArgs struct {
+	*QueryGroupsOptions `query:",inline,omitempty"`
+
+	Query string `query:"query2"`
+}
+
+// QueryGroups queries internal groups visible to the caller.
+// Group predicates include name:, inname:, description:, owner:, member:, subgroup:, uuid: and is:visibletoall,
+// for example And(F("inname", "dev"), F("owner", "Administrators")).
+// If the number of groups is limited, the last group of the result has MoreGroups set if there are more groups.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#query-groups
+func (s *GroupsService) QueryGroups(ctx context.Context, query Query, opts *QueryGroupsOptions) ([]*GroupInfo, error) {
+	args := &queryGroupsArgs{
+		QueryGroupsOptions: opts,
+		Query:              query.String(),
+	}
+
+	var reply []*GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "groups/", args, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
@@ -0,0 +1,486 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GroupMembership is the desired direct membership of a Gerrit internal group.
+type GroupMembership struct {
+	// Members are account IDs, usernames or emails.
+	Members []string
+	// Subgroups are the names or UUIDs of the included groups.
+	Subgroups []string
+}
+
+// ReconcileGroupsOptions specifies the options for the ReconcileGroups call.
+type ReconcileGroupsOptions struct {
+	// DryRun only computes the plan without changing any group.
+	DryRun bool
+}
+
+// ErrUnresolvedMembership is the error of a group whose desired members or subgroups could not all be resolved.
+// Such a group is not changed, as the current members the unresolved identifiers stand for would be removed.
+var ErrUnresolvedMembership = errors.New("unresolved members or subgroups")
+
+// GroupReconcileStep is one of the changes ReconcileGroups applies to a group, in the order of the constants.
+type GroupReconcileStep string
+
+const (
+	ReconcileAddMembers      GroupReconcileStep = "add members"
+	ReconcileRemoveMembers   GroupReconcileStep = "remove members"
+	ReconcileAddSubgroups    GroupReconcileStep = "add subgroups"
+	ReconcileRemoveSubgroups GroupReconcileStep = "remove subgroups"
+)
+
+// GroupReconcileResult is the plan, and its outcome, for a single group.
+type GroupReconcileResult struct {
+	// Group is the name or UUID the desired membership was given for.
+	Group string
+	// GroupID is the URL encoded UUID of the group, as returned by the REST API,
+	// empty if the group was not found.
+	GroupID string
+
+	AddMembers      []*AccountInfo
+	RemoveMembers   []*AccountInfo
+	AddSubgroups    []*GroupInfo
+	RemoveSubgroups []*GroupInfo
+
+	// Unresolved lists the desired members and subgroups that matched no or several accounts or groups.
+	// They are left out of the plan, and the plan is not applied if there are any, see ErrUnresolvedMembership.
+	Unresolved []string
+
+	// Applied reports whether the whole plan was applied to the group.
+	Applied bool
+	// AppliedSteps lists the steps of the plan that were applied.
+	// If applying the plan failed, the group was left with only these steps applied.
+	AppliedSteps []GroupReconcileStep
+	// Err is the error that prevented the plan from being computed or applied.
+	Err error
+}
+
+// Adds returns the number of members and subgroups to add.
+func (r *GroupReconcileResult) Adds() int {
+	return len(r.AddMembers) + len(r.AddSubgroups)
+}
+
+// Removes returns the number of members and subgroups to remove.
+func (r *GroupReconcileResult) Removes() int {
+	return len(r.RemoveMembers) + len(r.RemoveSubgroups)
+}
+
+// PartiallyApplied reports whether applying the plan failed after some of its steps were applied.
+func (r *GroupReconcileResult) PartiallyApplied() bool {
+	return !r.Applied && len(r.AppliedSteps) > 0
+}
+
+// GroupsReconcileReport is the result of a ReconcileGroups call.
+type GroupsReconcileReport struct {
+	DryRun bool
+	// Groups are sorted by the group name or UUID the desired membership was given for.
+	Groups []*GroupReconcileResult
+}
+
+// Adds returns the number of members and subgroups to add over all groups.
+func (r *GroupsReconcileReport) Adds() int {
+	n := 0
+	for _, g := range r.Groups {
+		n += g.Adds()
+	}
+	return n
+}
+
+// Removes returns the number of members and subgroups to remove over all groups.
+func (r *GroupsReconcileReport) Removes() int {
+	n := 0
+	for _, g := range r.Groups {
+		n += g.Removes()
+	}
+	return n
+}
+
+// Err returns the errors of all groups joined, or nil if every group was planned and applied.
+func (r *GroupsReconcileReport) Err() error {
+	var errs []error
+	for _, g := range r.Groups {
+		switch {
+		case g.PartiallyApplied():
+			errs = append(errs, fmt.Errorf("group %s, partially applied %v: %w", g.Group, g.AppliedSteps, g.Err))
+		case g.Err != nil:
+			errs = append(errs, fmt.Errorf("group %s: %w", g.Group, g.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ReconcileGroups brings the direct members and subgroups of Gerrit internal groups to the desired state,
+// keyed by group name or UUID. Groups that are not part of desired are left untouched.
+//
+// The current state is loaded with ListGroups, falling back to ListGroupMembers and ListSubgroups for groups
+// that are not listed. Desired members that are not current members are resolved with QueryAccounts.
+// A group with unresolved members or subgroups is planned, but not applied.
+// A failure of a single group is recorded in its result and does not stop the other groups;
+// the returned error is only set if the current state could not be loaded.
+// The steps of a group are applied one after the other and a failed step stops the group,
+// the steps applied before are listed in the AppliedSteps of its result.
+func (s *GroupsService) ReconcileGroups(ctx context.Context, desired map[string]GroupMembership, opts *ReconcileGroupsOptions) (*GroupsReconcileReport, error) {
+	report := new(GroupsReconcileReport)
+	if opts != nil {
+		report.DryRun = opts.DryRun
+	}
+
+	groups, err := s.ListGroups(ctx, &ListGroupsOptions{
+		AdditionalFields: []GroupAdditionalField{MEMBERS, INCLUDES},
+	})
+	if err != nil {
+		return nil, err
+	}
+	index := newGroupIndex(groups)
+
+	for _, key := range sortedKeys(desired) {
+		result, err := s.planGroup(ctx, index, key, desired[key])
+		switch {
+		case err != nil:
+			result = &GroupReconcileResult{Group: key, Err: err}
+		case len(result.Unresolved) > 0:
+			result.Err = fmt.Errorf("%w: %s", ErrUnresolvedMembership, strings.Join(result.Unresolved, ", "))
+		case !report.DryRun:
+			result.Err = s.applyGroupPlan(ctx, result)
+			result.Applied = result.Err == nil
+		}
+		report.Groups = append(report.Groups, result)
+	}
+	return report, nil
+}
+
+func (s *GroupsService) planGroup(ctx context.Context, index *groupIndex, group string, desired GroupMembership) (*GroupReconcileResult, error) {
+	current, err := s.loadGroup(ctx, index, group)
+	if err != nil {
+		return nil, err
+	}
+	members, unresolved, err := s.resolveMembers(ctx, current.Members, desired.Members)
+	if err != nil {
+		return nil, err
+	}
+	subgroups, unresolvedGroups := index.resolve(current.Includes, desired.Subgroups)
+
+	plan := planGroupMembership(current, members, subgroups)
+	plan.Group = group
+	plan.Unresolved = append(unresolved, unresolvedGroups...)
+	return plan, nil
+}
+
+func (s *GroupsService) loadGroup(ctx context.Context, index *groupIndex, group string) (*GroupInfo, error) {
+	if g := index.lookup(group); g != nil {
+		return g, nil
+	}
+
+	g, err := s.GetGroup(ctx, url.PathEscape(group))
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.ListGroupMembers(ctx, g.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	subgroups, err := s.ListSubgroups(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		g.Members = append(g.Members, *m)
+	}
+	for _, sg := range subgroups {
+		g.Includes = append(g.Includes, *sg)
+	}
+	return g, nil
+}
+
+// resolveAccountsBatch is the number of identifiers resolved by a single account query.
+const resolveAccountsBatch = 50
+
+// resolveMembers maps the desired member identifiers to accounts.
+// Current members are matched locally, the remaining identifiers are looked up in batches.
+func (s *GroupsService) resolveMembers(ctx context.Context, current []AccountInfo, identifiers []string) ([]*AccountInfo, []string, error) {
+	var (
+		reply   []*AccountInfo
+		pending []string
+	)
+	for _, id := range identifiers {
+		if a := matchAccount(current, id); a != nil {
+			reply = append(reply, a)
+		} else {
+			pending = append(pending, id)
+		}
+	}
+
+	var unresolved []string
+	for len(pending) > 0 {
+		batch := pending
+		if len(batch) > resolveAccountsBatch {
+			batch = batch[:resolveAccountsBatch]
+		}
+		pending = pending[len(batch):]
+
+		qs := make([]Query, 0, len(batch))
+		for _, id := range batch {
+			qs = append(qs, accountQuery(id))
+		}
+		found, err := s.queryAllAccounts(ctx, Or(qs...).String())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, id := range batch {
+			if a := matchAccount(found, id); a != nil {
+				reply = append(reply, a)
+			} else {
+				unresolved = append(unresolved, id)
+			}
+		}
+	}
+	return reply, unresolved, nil
+}
+
+// queryAllAccounts pages through all accounts matching query, with details and all emails.
+func (s *GroupsService) queryAllAccounts(ctx context.Context, query string) ([]AccountInfo, error) {
+	opts := &QueryAccountsOptions{
+		ListOptions:      NewListOptions(0, 2*resolveAccountsBatch),
+		AdditionalFields: []AccountAdditionalField{DETAILS, ALL_EMAILS},
+	}
+
+	var reply []AccountInfo
+	for {
+		accounts, err := s.client.Accounts.QueryAccounts(ctx, query, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range accounts {
+			reply = append(reply, *a)
+		}
+		if len(accounts) == 0 || !accounts[len(accounts)-1].MoreAccounts {
+			return reply, nil
+		}
+		opts.Skip += len(accounts)
+	}
+}
+
+func accountQuery(identifier string) Query {
+	switch {
+	case strings.Contains(identifier, "@"):
+		return F("email", identifier)
+	case isAccountID(identifier):
+		return Raw(identifier)
+	default:
+		return F("username", identifier)
+	}
+}
+
+func isAccountID(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// matchAccount returns the only account identified by an account ID, username or email,
+// or nil if none or several accounts match.
+func matchAccount(accounts []AccountInfo, identifier string) *AccountInfo {
+	var match *AccountInfo
+	for i := range accounts {
+		a := &accounts[i]
+		ok := strconv.Itoa(a.AccountID) == identifier ||
+			(a.Username != "" && strings.EqualFold(a.Username, identifier)) ||
+			(a.Email != "" && strings.EqualFold(a.Email, identifier))
+		for _, e := range a.SecondaryEmails {
+			ok = ok || strings.EqualFold(e, identifier)
+		}
+		if !ok {
+			continue
+		}
+		if match != nil && match.AccountID != a.AccountID {
+			return nil
+		}
+		match = a
+	}
+	return match
+}
+
+// planGroupMembership computes the changes needed to turn the current members and subgroups of a group into the desired ones.
+func planGroupMembership(current *GroupInfo, members []*AccountInfo, subgroups []*GroupInfo) *GroupReconcileResult {
+	plan := &GroupReconcileResult{GroupID: current.ID}
+
+	want := make(map[int]bool, len(members))
+	for _, a := range members {
+		if !want[a.AccountID] {
+			want[a.AccountID] = true
+			if !hasMember(current.Members, a.AccountID) {
+				plan.AddMembers = append(plan.AddMembers, a)
+			}
+		}
+	}
+	for i := range current.Members {
+		if a := &current.Members[i]; !want[a.AccountID] {
+			plan.RemoveMembers = append(plan.RemoveMembers, a)
+		}
+	}
+
+	wantGroups := make(map[string]bool, len(subgroups))
+	for _, g := range subgroups {
+		if !wantGroups[g.ID] {
+			wantGroups[g.ID] = true
+			if !hasSubgroup(current.Includes, g.ID) {
+				plan.AddSubgroups = append(plan.AddSubgroups, g)
+			}
+		}
+	}
+	for i := range current.Includes {
+		if g := &current.Includes[i]; !wantGroups[g.ID] {
+			plan.RemoveSubgroups = append(plan.RemoveSubgroups, g)
+		}
+	}
+	return plan
+}
+
+func hasMember(members []AccountInfo, id int) bool {
+	for _, m := range members {
+		if m.AccountID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSubgroup(groups []GroupInfo, id string) bool {
+	for _, g := range groups {
+		if g.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// applyGroupPlan applies the steps of a plan in order, recording the applied ones in plan.AppliedSteps.
+// plan.GroupID is URL encoded already and used in the paths as is.
+func (s *GroupsService) applyGroupPlan(ctx context.Context, plan *GroupReconcileResult) error {
+	steps := []struct {
+		step  GroupReconcileStep
+		count int
+		apply func() error
+	}{
+		{ReconcileAddMembers, len(plan.AddMembers), func() error {
+			_, err := s.AddGroupMembers(ctx, plan.GroupID, accountIDs(plan.AddMembers))
+			return err
+		}},
+		{ReconcileRemoveMembers, len(plan.RemoveMembers), func() error {
+			return s.RemoveGroupMembers(ctx, plan.GroupID, accountIDs(plan.RemoveMembers))
+		}},
+		{ReconcileAddSubgroups, len(plan.AddSubgroups), func() error {
+			_, err := s.AddSubgroups(ctx, plan.GroupID, groupUUIDs(plan.AddSubgroups))
+			return err
+		}},
+		{ReconcileRemoveSubgroups, len(plan.RemoveSubgroups), func() error {
+			return s.RemoveSubgroups(ctx, plan.GroupID, groupUUIDs(plan.RemoveSubgroups))
+		}},
+	}
+	for _, step := range steps {
+		if step.count == 0 {
+			continue
+		}
+		if err := step.apply(); err != nil {
+			return fmt.Errorf("%s: %w", step.step, err)
+		}
+		plan.AppliedSteps = append(plan.AppliedSteps, step.step)
+	}
+	return nil
+}
+
+func accountIDs(accounts []*AccountInfo) []string {
+	reply := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		reply = append(reply, strconv.Itoa(a.AccountID))
+	}
+	return reply
+}
+
+// groupUUIDs returns the decoded UUIDs of groups, as expected in request bodies.
+func groupUUIDs(groups []*GroupInfo) []string {
+	reply := make([]string, 0, len(groups))
+	for _, g := range groups {
+		reply = append(reply, groupUUID(g))
+	}
+	return reply
+}
+
+// groupUUID returns the decoded UUID of a group, whose ID is URL encoded by the REST API.
+func groupUUID(g *GroupInfo) string {
+	if id, err := url.PathUnescape(g.ID); err == nil {
+		return id
+	}
+	return g.ID
+}
+
+// groupIndex looks up listed groups by name or decoded UUID.
+type groupIndex struct {
+	byName map[string]*GroupInfo
+	byID   map[string]*GroupInfo
+}
+
+func newGroupIndex(groups map[string]*GroupInfo) *groupIndex {
+	idx := &groupIndex{
+		byName: make(map[string]*GroupInfo, len(groups)),
+		byID:   make(map[string]*GroupInfo, len(groups)),
+	}
+	for name, g := range groups {
+		if g == nil {
+			continue
+		}
+		if g.Name == "" {
+			g.Name = name
+		}
+		idx.byName[g.Name] = g
+		idx.byID[groupUUID(g)] = g
+	}
+	return idx
+}
+
+func (idx *groupIndex) lookup(group string) *GroupInfo {
+	if g, ok := idx.byName[group]; ok {
+		return g
+	}
+	if g, ok := idx.byID[group]; ok {
+		return g
+	}
+	// Group UUIDs may also be given URL encoded, as returned by the REST API.
+	if id, err := url.PathUnescape(group); err == nil {
+		return idx.byID[id]
+	}
+	return nil
+}
+
+// resolve maps the desired subgroups to groups, trying the current subgroups before the listed groups.
+func (idx *groupIndex) resolve(current []GroupInfo, subgroups []string) ([]*GroupInfo, []string) {
+	var (
+		reply      []*GroupInfo
+		unresolved []string
+	)
+	for _, sg := range subgroups {
+		var match *GroupInfo
+		for i := range current {
+			if groupUUID(&current[i]) == sg || current[i].ID == sg || current[i].Name == sg {
+				match = &current[i]
+				break
+			}
+		}
+		if match == nil {
+			match = idx.lookup(sg)
+		}
+		if match == nil {
+			unresolved = append(unresolved, sg)
+			continue
+		}
+		reply = append(reply, match)
+	}
+	return reply, unresolved
+}
@@ -0,0 +1,192 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGroupsReconcilePlan(t *testing.T) {
+	current := &GroupInfo{
+		ID:   "a1b2",
+		Name: "Developers",
+		Members: []AccountInfo{
+			{AccountID: 1000, Username: "jdoe", Email: "jdoe@example.com"},
+			{AccountID: 1001, Username: "jroe", Email: "jroe@example.com", SecondaryEmails: []string{"jane@example.com"}},
+		},
+		Includes: []GroupInfo{
+			{ID: "c3d4", Name: "Contractors"},
+		},
+	}
+	groups := map[string]*GroupInfo{
+		"Developers":  current,
+		"Contractors": {ID: "c3d4"},
+		"Reviewers":   {ID: "e5f6"},
+		"LDAP Devs":   {ID: "ldap%3Acn%3Ddevs%2Cou%3Dgroups"},
+	}
+	index := newGroupIndex(groups)
+
+	if g := index.lookup("a1b2"); g != current {
+		t.Errorf("lookup by UUID: got %+v", g)
+	}
+	if g := index.lookup("Reviewers"); g == nil || g.Name != "Reviewers" {
+		t.Errorf("lookup by name: got %+v", g)
+	}
+	for _, id := range []string{"ldap:cn=devs,ou=groups", "ldap%3Acn%3Ddevs%2Cou%3Dgroups"} {
+		if g := index.lookup(id); g == nil || g.Name != "LDAP Devs" {
+			t.Errorf("lookup by external UUID %q: got %+v", id, g)
+		}
+	}
+
+	if a := matchAccount(current.Members, "JANE@example.com"); a == nil || a.AccountID != 1001 {
+		t.Errorf("match by secondary email: got %+v", a)
+	}
+	if a := matchAccount(current.Members, "1000"); a == nil || a.Username != "jdoe" {
+		t.Errorf("match by account ID: got %+v", a)
+	}
+	ambiguous := []AccountInfo{{AccountID: 1, Email: "x@example.com"}, {AccountID: 2, Username: "x@example.com"}}
+	if a := matchAccount(ambiguous, "x@example.com"); a != nil {
+		t.Errorf("ambiguous match: got %+v", a)
+	}
+
+	subgroups, unresolved := index.resolve(current.Includes, []string{"Reviewers", "ldap:cn=devs,ou=groups", "Testers"})
+	if !reflect.DeepEqual(unresolved, []string{"Testers"}) {
+		t.Errorf("unresolved: got %v", unresolved)
+	}
+
+	jdoe := matchAccount(current.Members, "jdoe")
+	newcomer := &AccountInfo{AccountID: 1002, Username: "newcomer"}
+	plan := planGroupMembership(current, []*AccountInfo{jdoe, newcomer, jdoe}, subgroups)
+
+	ids := func(accounts []*AccountInfo) []int {
+		var reply []int
+		for _, a := range accounts {
+			reply = append(reply, a.AccountID)
+		}
+		return reply
+	}
+	if got := ids(plan.AddMembers); !reflect.DeepEqual(got, []int{1002}) {
+		t.Errorf("add members: got %v", got)
+	}
+	if got := ids(plan.RemoveMembers); !reflect.DeepEqual(got, []int{1001}) {
+		t.Errorf("remove members: got %v", got)
+	}
+	if got := groupUUIDs(plan.AddSubgroups); !reflect.DeepEqual(got, []string{"e5f6", "ldap:cn=devs,ou=groups"}) {
+		t.Errorf("add subgroups: got %v", got)
+	}
+	if got := groupUUIDs(plan.RemoveSubgroups); !reflect.DeepEqual(got, []string{"c3d4"}) {
+		t.Errorf("remove subgroups: got %v", got)
+	}
+
+	report := &GroupsReconcileReport{DryRun: true, Groups: []*GroupReconcileResult{plan}}
+	if report.Adds() != 3 || report.Removes() != 2 || report.Err() != nil {
+		t.Errorf("report: adds %d, removes %d, err %v", report.Adds(), report.Removes(), report.Err())
+	}
+
+	if q := accountQuery("jdoe@example.com").String(); q != "email:jdoe@example.com" {
+		t.Errorf("email query: got %q", q)
+	}
+	if q := accountQuery("1000").String(); q != "1000" {
+		t.Errorf("account ID query: got %q", q)
+	}
+}
+
+func TestGroupsReconcileApply(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath()+" "+strings.TrimSpace(string(body)))
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/groups.delete"):
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		case strings.HasSuffix(r.URL.Path, ".delete"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, ")]}'\n[]")
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+
+	plan := &GroupReconcileResult{
+		Group:           "Developers",
+		GroupID:         "a1b2",
+		AddMembers:      []*AccountInfo{{AccountID: 1002}},
+		RemoveMembers:   []*AccountInfo{{AccountID: 1001}},
+		AddSubgroups:    []*GroupInfo{{ID: "ldap%3Acn%3Ddevs%2Cou%3Dgroups"}},
+		RemoveSubgroups: []*GroupInfo{{ID: "c3d4"}},
+	}
+	plan.Err = client.Groups.applyGroupPlan(context.Background(), plan)
+	if plan.Err == nil {
+		t.Fatal("want error of the failed step")
+	}
+
+	want := []string{
+		`POST /a/groups/a1b2/members.add {"members":["1002"]}`,
+		`POST /a/groups/a1b2/members.delete {"members":["1001"]}`,
+		`POST /a/groups/a1b2/groups.add {"groups":["ldap:cn=devs,ou=groups"]}`,
+		`POST /a/groups/a1b2/groups.delete {"groups":["c3d4"]}`,
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+	steps := []GroupReconcileStep{ReconcileAddMembers, ReconcileRemoveMembers, ReconcileAddSubgroups}
+	if !reflect.DeepEqual(plan.AppliedSteps, steps) || !plan.PartiallyApplied() {
+		t.Errorf("applied steps: got %v", plan.AppliedSteps)
+	}
+
+	report := &GroupsReconcileReport{Groups: []*GroupReconcileResult{plan}}
+	if err := report.Err(); err == nil || !strings.Contains(err.Error(), "partially applied") {
+		t.Errorf("report: got %v", err)
+	}
+}
+
+func TestGroupsReconcileUnresolved(t *testing.T) {
+	var changes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			changes = append(changes, r.Method+" "+r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/a/groups/":
+			fmt.Fprint(w, `)]}'
+{"Developers": {"id": "a1b2", "members": [
+  {"_account_id": 1000, "username": "jdoe", "email": "jdoe@example.com"},
+  {"_account_id": 1001, "username": "jroe", "email": "jroe@example.com"}
+]}}`)
+		default:
+			fmt.Fprint(w, ")]}'\n[]")
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+
+	report, err := client.Groups.ReconcileGroups(context.Background(), map[string]GroupMembership{
+		// jroee is a typo of jroe, who must not be removed because of it.
+		"Developers": {Members: []string{"jdoe", "jroee"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 0 {
+		t.Errorf("changes: got %v, want none", changes)
+	}
+	result := report.Groups[0]
+	if result.Applied || !errors.Is(result.Err, ErrUnresolvedMembership) || !reflect.DeepEqual(result.Unresolved, []string{"jroee"}) {
+		t.Errorf("result: got applied %v, unresolved %v, err %v", result.Applied, result.Unresolved, result.Err)
+	}
+	if !errors.Is(report.Err(), ErrUnresolvedMembership) {
+		t.Errorf("report: got %v", report.Err())
+	}
+}
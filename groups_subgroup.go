@@ -0,0 +1,55 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GroupsInput entity contains information about groups that should be included into a group or that should be deleted from a group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#groups-input
+type GroupsInput struct {
+	// Groups are the IDs, UUIDs or names of the groups.
+	Groups []string `json:"groups"`
+}
+
+// ListSubgroups lists the direct subgroups of a group.
+// The entries in the list are sorted by group name and UUID.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#list-subgroups
+func (s *GroupsService) ListSubgroups(ctx context.Context, groupID string) ([]*GroupInfo, error) {
+	u := fmt.Sprintf("groups/%s/groups/", groupID)
+
+	var reply []*GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// AddSubgroups adds one or several groups as subgroups to a Gerrit internal group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#add-subgroups
+func (s *GroupsService) AddSubgroups(ctx context.Context, groupID string, groups []string) ([]*GroupInfo, error) {
+	u := fmt.Sprintf("groups/%s/groups.add", groupID)
+
+	var reply []*GroupInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, &GroupsInput{Groups: groups}, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// RemoveSubgroups removes one or several subgroups from a Gerrit internal group.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#remove-subgroups
+func (s *GroupsService) RemoveSubgroups(ctx context.Context, groupID string, groups []string) error {
+	u := fmt.Sprintf("groups/%s/groups.delete", groupID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, &GroupsInput{Groups: groups}, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+)
+
+func TestGroupsService_ListSubgroups(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	reply, err := client.Groups.ListSubgroups(context.Background(), "Administrators")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("subgroups: %v", len(reply))
+}
+
+func TestGroupsService_AddSubgroups(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	reply, err := client.Groups.AddSubgroups(context.Background(), "test-group", []string{"Administrators"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("subgroups: %v", len(reply))
+}
+
+func TestGroupsService_RemoveSubgroups(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+	err := client.Groups.RemoveSubgroups(context.Background(), "test-group", []string{"Administrators"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("remove subgroups ok!")
+}
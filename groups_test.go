@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
 )
 
 func TestGroupsService_ListGroups(t *testing.T) {
@@ -21,3 +22,199 @@ func TestGroupsService_ListGroups(t *testing.T) {
 
 	t.Logf("reply: %v", reply)
 }
+
+func TestGroupsService_GetGroup(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.GetGroup(context.Background(), "Administrators")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("group: %+v", reply)
+}
+
+func TestGroupsService_GetGroupDetail(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.GetGroupDetail(context.Background(), "Administrators")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("group: %+v, members: %d", reply, len(reply.Members))
+}
+
+func TestGroupsService_CreateGroup(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.CreateGroup(context.Background(), "test-group", &gerrit.GroupInput{
+		Description:  ptr.Ptr("created by go-gerrit"),
+		VisibleToAll: ptr.Ptr(true),
+		OwnerID:      ptr.Ptr("Administrators"),
+		Members:      []string{"admin"},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("group: %+v", reply)
+}
+
+func TestGroupsService_RenameGroup(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.RenameGroup(context.Background(), "test-group", "test-group-renamed")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("name: %s", reply)
+}
+
+func TestGroupsService_SetDescription(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.SetDescription(context.Background(), "test-group", "updated by go-gerrit")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("description: %s", reply)
+}
+
+func TestGroupsService_DeleteDescription(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Groups.DeleteDescription(context.Background(), "test-group")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete description ok!")
+}
+
+func TestGroupsService_SetOwner(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.SetOwner(context.Background(), "test-group", "Administrators")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("owner: %+v", reply)
+}
+
+func TestGroupsService_SetOptions(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.SetOptions(context.Background(), "test-group", &gerrit.GroupOptionsInput{
+		VisibleToAll: ptr.Ptr(false),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("options: %+v", reply)
+}
+
+func TestGroupsService_ReconcileGroups(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.ReconcileGroups(context.Background(), map[string]gerrit.GroupMembership{
+		"test-group": {
+			Members:   []string{"admin", "test@example.com"},
+			Subgroups: []string{"Administrators"},
+		},
+	}, &gerrit.ReconcileGroupsOptions{DryRun: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, g := range reply.Groups {
+		t.Logf("group %s: +%d -%d, unresolved: %v, err: %v", g.Group, g.Adds(), g.Removes(), g.Unresolved, g.Err)
+	}
+}
+
+func TestGroupsService_GetGroupAuditLog(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.GetGroupAuditLog(context.Background(), "Administrators")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range reply {
+		t.Logf("%s %s by %s", e.Date, e.Type, e.User.Username)
+	}
+}
+
+func TestGroupsService_ListGroups_Filters(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Groups.ListGroups(context.Background(), &gerrit.ListGroupsOptions{
+		User:      ptr.Ptr("self"),
+		Substring: ptr.Ptr("admin"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("groups: %v", len(reply))
+}
+
+func TestGroupsService_QueryGroups(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	q := gerrit.Or(
+		gerrit.F("member", "admin"),
+		gerrit.F("owner", "Administrators"),
+	)
+
+	reply, err := client.Groups.QueryGroups(context.Background(), q, &gerrit.QueryGroupsOptions{
+		ListOptions: gerrit.NewListOptions(0, 100),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, g := range reply {
+		t.Logf("group: %s (%s)", g.Name, g.ID)
+	}
+}
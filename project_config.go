@@ -0,0 +1,596 @@
+package gerrit
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ProjectConfigFile is the name of the file in refs/meta/config holding the project configuration.
+const ProjectConfigFile = "project.config"
+
+// ProjectConfig is an editable representation of the project.config file stored in refs/meta/config.
+//
+// The file uses git-config syntax. Comments, blank lines and the order of sections and keys are preserved,
+// so a config that is parsed and written back without modifications produces identical output.
+//
+// Gerrit docs: https://gerrit-review.googlesource.com/Documentation/config-project-config.html
+type ProjectConfig struct {
+	// preamble holds the comments and blank lines preceding the first section.
+	preamble []string
+	sections []*ConfigSection
+
+	noFinalNewline bool
+}
+
+// ConfigSection is a single [name "subsection"] block of a ProjectConfig.
+type ConfigSection struct {
+	Name       string
+	Subsection string
+
+	header string
+	lines  []*configLine
+}
+
+// configLine is either a key/value entry or a comment or blank line.
+// raw holds the original text and is cleared once the entry is modified,
+// comment then holds the inline comment of the original text to write after the new value.
+type configLine struct {
+	raw     string
+	key     string
+	value   string
+	comment string
+}
+
+func (l *configLine) isEntry() bool {
+	return l.key != ""
+}
+
+// ParseProjectConfig parses the content of a project.config file.
+func ParseProjectConfig(data []byte) (*ProjectConfig, error) {
+	c := new(ProjectConfig)
+	lines, noFinalNewline := splitLines(string(data))
+	c.noFinalNewline = noFinalNewline
+
+	var cur *ConfigSection
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" || trimmed[0] == '#' || trimmed[0] == ';':
+			if cur == nil {
+				c.preamble = append(c.preamble, line)
+			} else {
+				cur.lines = append(cur.lines, &configLine{raw: line})
+			}
+		case trimmed[0] == '[':
+			name, sub, err := parseSectionHeader(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("project config: line %d: %w", i+1, err)
+			}
+			cur = &ConfigSection{Name: name, Subsection: sub, header: line}
+			c.sections = append(c.sections, cur)
+		default:
+			if cur == nil {
+				return nil, fmt.Errorf("project config: line %d: key outside of a section", i+1)
+			}
+			start := i
+			raw := line
+			key, value, more, err := parseConfigEntry(raw)
+			for err == nil && more && i+1 < len(lines) {
+				i++
+				raw += "\n" + lines[i]
+				key, value, more, err = parseConfigEntry(raw)
+			}
+			if err == nil && more {
+				err = errors.New("unexpected end of file after line continuation")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("project config: line %d: %w", start+1, err)
+			}
+			cur.lines = append(cur.lines, &configLine{raw: raw, key: key, value: value})
+		}
+	}
+	return c, nil
+}
+
+// Sections returns all sections in file order.
+func (c *ProjectConfig) Sections() []*ConfigSection {
+	return c.sections
+}
+
+// Section returns the section with the given name and subsection, or nil if there is none.
+// Section names are case-insensitive, subsections are case-sensitive.
+func (c *ProjectConfig) Section(name, subsection string) *ConfigSection {
+	for _, s := range c.sections {
+		if s.is(name, subsection) {
+			return s
+		}
+	}
+	return nil
+}
+
+// SectionsNamed returns all sections with the given name in file order, whatever their subsection.
+func (c *ProjectConfig) SectionsNamed(name string) []*ConfigSection {
+	var reply []*ConfigSection
+	for _, s := range c.sections {
+		if strings.EqualFold(s.Name, name) {
+			reply = append(reply, s)
+		}
+	}
+	return reply
+}
+
+// AddSection returns the section with the given name and subsection,
+// appending an empty one to the end of the file if it does not exist yet.
+func (c *ProjectConfig) AddSection(name, subsection string) *ConfigSection {
+	if s := c.Section(name, subsection); s != nil {
+		return s
+	}
+	s := &ConfigSection{Name: name, Subsection: subsection}
+	c.sections = append(c.sections, s)
+	return s
+}
+
+// RemoveSection removes the section with the given name and subsection
+// together with all of its entries and comments.
+// It reports whether the section existed.
+func (c *ProjectConfig) RemoveSection(name, subsection string) bool {
+	for i, s := range c.sections {
+		if s.is(name, subsection) {
+			c.sections = append(c.sections[:i], c.sections[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Bytes returns the content of the file in git-config syntax.
+func (c *ProjectConfig) Bytes() []byte {
+	return []byte(c.String())
+}
+
+func (c *ProjectConfig) String() string {
+	var lines []string
+	lines = append(lines, c.preamble...)
+	for _, s := range c.sections {
+		lines = append(lines, s.headerLine())
+		for _, l := range s.lines {
+			lines = append(lines, l.String())
+		}
+	}
+	return joinLines(lines, c.noFinalNewline)
+}
+
+func (s *ConfigSection) is(name, subsection string) bool {
+	return strings.EqualFold(s.Name, name) && s.Subsection == subsection
+}
+
+func (s *ConfigSection) headerLine() string {
+	if s.header != "" {
+		return s.header
+	}
+	if s.Subsection == "" {
+		return "[" + s.Name + "]"
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return "[" + s.Name + ` "` + r.Replace(s.Subsection) + `"]`
+}
+
+// Keys returns the distinct keys of the section in the order of their first appearance.
+func (s *ConfigSection) Keys() []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, l := range s.lines {
+		if !l.isEntry() {
+			continue
+		}
+		k := strings.ToLower(l.key)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		keys = append(keys, l.key)
+	}
+	return keys
+}
+
+// Has reports whether the section contains the key.
+func (s *ConfigSection) Has(key string) bool {
+	return len(s.indexes(key)) > 0
+}
+
+// Get returns the last value of the key, or an empty string if the key is not set.
+// Keys are case-insensitive.
+func (s *ConfigSection) Get(key string) string {
+	idx := s.indexes(key)
+	if len(idx) == 0 {
+		return ""
+	}
+	return s.lines[idx[len(idx)-1]].value
+}
+
+// GetAll returns all values of a multi-valued key.
+func (s *ConfigSection) GetAll(key string) []string {
+	var values []string
+	for _, i := range s.indexes(key) {
+		values = append(values, s.lines[i].value)
+	}
+	return values
+}
+
+// Set replaces all values of the key with a single value.
+func (s *ConfigSection) Set(key, value string) {
+	s.SetAll(key, []string{value})
+}
+
+// SetAll replaces all values of the key.
+// Entries whose value is kept stay in place with their original formatting,
+// entries whose value is dropped are reused for new values or removed,
+// and any remaining values are inserted after the last entry of the key,
+// or at the end of the section if the key is new.
+func (s *ConfigSection) SetAll(key string, values []string) {
+	idx := s.indexes(key)
+	used := make([]bool, len(values))
+	matched := make([]bool, len(idx))
+	for n, i := range idx {
+		for j, v := range values {
+			if !used[j] && s.lines[i].value == v {
+				used[j], matched[n] = true, true
+				break
+			}
+		}
+	}
+	var pending []string
+	for j, v := range values {
+		if !used[j] {
+			pending = append(pending, v)
+		}
+	}
+
+	remove := make(map[int]bool)
+	for n, i := range idx {
+		if matched[n] {
+			continue
+		}
+		if len(pending) > 0 {
+			s.lines[i].setValue(pending[0])
+			pending = pending[1:]
+			continue
+		}
+		remove[i] = true
+	}
+
+	if len(remove) > 0 {
+		lines := s.lines[:0]
+		for i, l := range s.lines {
+			if !remove[i] {
+				lines = append(lines, l)
+			}
+		}
+		s.lines = lines
+		return
+	}
+
+	if len(pending) > 0 {
+		pos := s.appendIndex()
+		if len(idx) > 0 {
+			pos = idx[len(idx)-1] + 1
+		}
+		added := make([]*configLine, 0, len(pending))
+		for _, v := range pending {
+			added = append(added, &configLine{key: key, value: v})
+		}
+		lines := make([]*configLine, 0, len(s.lines)+len(added))
+		lines = append(lines, s.lines[:pos]...)
+		lines = append(lines, added...)
+		lines = append(lines, s.lines[pos:]...)
+		s.lines = lines
+	}
+}
+
+// Add appends a value to a multi-valued key.
+func (s *ConfigSection) Add(key, value string) {
+	s.SetAll(key, append(s.GetAll(key), value))
+}
+
+// Unset removes all values of the key.
+func (s *ConfigSection) Unset(key string) {
+	s.SetAll(key, nil)
+}
+
+func (s *ConfigSection) indexes(key string) []int {
+	var idx []int
+	for i, l := range s.lines {
+		if l.isEntry() && strings.EqualFold(l.key, key) {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// appendIndex returns the position after the last non-blank line of the section,
+// so blank lines separating it from the next section stay at the end.
+func (s *ConfigSection) appendIndex() int {
+	for i := len(s.lines) - 1; i >= 0; i-- {
+		if s.lines[i].isEntry() || strings.TrimSpace(s.lines[i].raw) != "" {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// setValue replaces the value of an entry, keeping its inline comment.
+func (l *configLine) setValue(v string) {
+	if l.raw != "" {
+		l.comment = inlineComment(l.raw)
+		l.raw = ""
+	}
+	l.value = v
+}
+
+func (l *configLine) String() string {
+	if l.raw != "" || !l.isEntry() {
+		return l.raw
+	}
+	v := formatConfigValue(l.value)
+	if v == "" {
+		return "\t" + l.key + " =" + l.comment
+	}
+	return "\t" + l.key + " = " + v + l.comment
+}
+
+// inlineComment returns the comment at the end of an entry, including the whitespace before it,
+// or "" if the entry has none.
+func inlineComment(raw string) string {
+	_, rest, ok := strings.Cut(raw, "=")
+	if !ok {
+		return ""
+	}
+	start := len(raw) - len(rest)
+	quoted := false
+	space := -1
+	for i := start; i < len(raw); i++ {
+		switch ch := raw[i]; {
+		case ch == '\\':
+			i++
+			space = -1
+		case ch == '"':
+			quoted = !quoted
+			space = -1
+		case quoted:
+		case ch == '#' || ch == ';':
+			if space < 0 {
+				space = i
+			}
+			return raw[space:]
+		case ch == ' ' || ch == '\t':
+			if space < 0 {
+				space = i
+			}
+		default:
+			space = -1
+		}
+	}
+	return ""
+}
+
+func splitLines(s string) ([]string, bool) {
+	if s == "" {
+		return nil, false
+	}
+	lines := strings.Split(s, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1], false
+	}
+	return lines, true
+}
+
+func joinLines(lines []string, noFinalNewline bool) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	s := strings.Join(lines, "\n")
+	if !noFinalNewline {
+		s += "\n"
+	}
+	return s
+}
+
+func parseSectionHeader(s string) (string, string, error) {
+	end := strings.LastIndexByte(s, ']')
+	if end < 0 {
+		return "", "", errors.New("unterminated section header")
+	}
+	if rest := strings.TrimSpace(s[end+1:]); rest != "" && rest[0] != '#' && rest[0] != ';' {
+		return "", "", fmt.Errorf("unexpected text after section header: %q", rest)
+	}
+	inner := strings.TrimSpace(s[1:end])
+	name, sub, found := strings.Cut(inner, " ")
+	if name == "" {
+		return "", "", errors.New("empty section name")
+	}
+	if !found {
+		return name, "", nil
+	}
+	sub = strings.TrimSpace(sub)
+	if len(sub) < 2 || sub[0] != '"' || sub[len(sub)-1] != '"' {
+		return "", "", fmt.Errorf("invalid subsection: %s", sub)
+	}
+	var b strings.Builder
+	for i := 1; i < len(sub)-1; i++ {
+		if sub[i] == '\\' && i+1 < len(sub)-1 {
+			i++
+		}
+		b.WriteByte(sub[i])
+	}
+	return name, b.String(), nil
+}
+
+// parseConfigEntry parses a "key = value" line.
+// It reports whether the value continues on the next line.
+func parseConfigEntry(s string) (string, string, bool, error) {
+	s = strings.TrimLeft(s, " \t")
+	i := 0
+	for i < len(s) && (isAlphaNum(s[i]) || s[i] == '-') {
+		i++
+	}
+	if i == 0 {
+		return "", "", false, fmt.Errorf("invalid key: %q", s)
+	}
+	key := s[:i]
+	rest := strings.TrimLeft(s[i:], " \t\r")
+	switch {
+	case rest == "" || rest[0] == '#' || rest[0] == ';':
+		// A key without a value is a boolean true.
+		return key, "true", false, nil
+	case rest[0] != '=':
+		return "", "", false, fmt.Errorf("invalid key: %q", s)
+	}
+	value, more, err := parseConfigValue(rest[1:])
+	return key, value, more, err
+}
+
+func parseConfigValue(s string) (string, bool, error) {
+	var b strings.Builder
+	var space strings.Builder
+	quoted := false
+	started := false
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case ch == '\\':
+			if i+1 >= len(s) {
+				return "", true, nil
+			}
+			i++
+			var esc byte
+			switch s[i] {
+			case '\n':
+				continue
+			case '\r':
+				if i+1 < len(s) && s[i+1] == '\n' {
+					i++
+					continue
+				}
+				return "", false, errors.New(`invalid escape sequence "\r"`)
+			case '\\', '"':
+				esc = s[i]
+			case 'n':
+				esc = '\n'
+			case 't':
+				esc = '\t'
+			case 'b':
+				esc = '\b'
+			default:
+				return "", false, fmt.Errorf(`invalid escape sequence "\%c"`, s[i])
+			}
+			b.WriteString(space.String())
+			space.Reset()
+			b.WriteByte(esc)
+			started = true
+		case ch == '"':
+			b.WriteString(space.String())
+			space.Reset()
+			quoted = !quoted
+			started = true
+		case quoted:
+			b.WriteByte(ch)
+		case ch == '#' || ch == ';':
+			return b.String(), false, nil
+		case ch == ' ' || ch == '\t' || ch == '\r':
+			if started {
+				space.WriteByte(ch)
+			}
+		case ch == '\n':
+			return "", false, errors.New("unexpected newline in value")
+		default:
+			b.WriteString(space.String())
+			space.Reset()
+			b.WriteByte(ch)
+			started = true
+		}
+	}
+	if quoted {
+		return "", false, errors.New("unterminated quoted value")
+	}
+	return b.String(), false, nil
+}
+
+func formatConfigValue(v string) string {
+	if v == "" {
+		return ""
+	}
+	quote := v[0] == ' ' || v[0] == '\t' || v[len(v)-1] == ' ' || v[len(v)-1] == '\t' ||
+		strings.ContainsAny(v, "#;")
+	var b strings.Builder
+	if quote {
+		b.WriteByte('"')
+	}
+	for i := 0; i < len(v); i++ {
+		switch ch := v[i]; ch {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\b':
+			b.WriteString(`\b`)
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	if quote {
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+func isAlphaNum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// parseConfigBool parses a git-config boolean, returning def if the value is empty.
+func parseConfigBool(v string, def bool) (bool, error) {
+	switch strings.ToLower(v) {
+	case "":
+		return def, nil
+	case "true", "yes", "on", "1":
+		return true, nil
+	case "false", "no", "off", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean value: %q", v)
+}
+
+// GetProjectConfig fetches and parses the project.config file from refs/meta/config of a project.
+func (s *ProjectsService) GetProjectConfig(ctx context.Context, projectName string) (*ProjectConfig, error) {
+	data, err := s.getMetaConfigFile(ctx, projectName, ProjectConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProjectConfig(data)
+}
+
+// GetGroupsFile fetches and parses the groups file from refs/meta/config of a project.
+func (s *ProjectsService) GetGroupsFile(ctx context.Context, projectName string) (*GroupsFile, error) {
+	data, err := s.getMetaConfigFile(ctx, projectName, GroupsFileName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsFile(data)
+}
+
+func (s *ProjectsService) getMetaConfigFile(ctx context.Context, projectName, fileName string) ([]byte, error) {
+	content, err := s.GetBranchContent(ctx, projectName, url.QueryEscape(MetaConfigRef), url.QueryEscape(fileName))
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(content)
+}
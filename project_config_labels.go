@@ -0,0 +1,125 @@
+package gerrit
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const labelSection = "label"
+
+// Labels returns the label definitions of the config keyed by label name.
+func (c *ProjectConfig) Labels() (map[string]LabelDefinitionInfo, error) {
+	reply := make(map[string]LabelDefinitionInfo)
+	for _, s := range c.SectionsNamed(labelSection) {
+		label, err := parseLabelSection(s)
+		if err != nil {
+			return nil, fmt.Errorf("label %q: %w", s.Subsection, err)
+		}
+		reply[label.Name] = *label
+	}
+	return reply, nil
+}
+
+// SetLabel replaces the definition of the label with the same name, creating its section if needed.
+func (c *ProjectConfig) SetLabel(label LabelDefinitionInfo) {
+	s := c.AddSection(labelSection, label.Name)
+	s.setString("function", label.Function)
+	s.setString("description", label.Description)
+	s.setInt("defaultValue", label.DefaultValue, 0)
+
+	type labelValue struct {
+		value int
+		text  string
+	}
+	values := make([]labelValue, 0, len(label.Values))
+	for k, text := range label.Values {
+		v, err := strconv.Atoi(strings.TrimSpace(k))
+		if err != nil {
+			continue
+		}
+		values = append(values, labelValue{v, text})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].value < values[j].value
+	})
+	lines := make([]string, 0, len(values))
+	for _, v := range values {
+		lines = append(lines, formatLabelValueLine(v.value, v.text))
+	}
+	s.SetAll("value", lines)
+
+	s.SetAll("branch", label.Branches)
+	s.setString("copyCondition", label.CopyCondition)
+	s.setBool("canOverride", label.CanOverride, true)
+	s.setBool("allowPostSubmit", label.AllowPostSubmit, true)
+	s.setBool("ignoreSelfApproval", label.IgnoreSelfApproval, false)
+}
+
+// RemoveLabel removes the definition of the label.
+// It reports whether the label existed.
+func (c *ProjectConfig) RemoveLabel(name string) bool {
+	return c.RemoveSection(labelSection, name)
+}
+
+func parseLabelSection(s *ConfigSection) (*LabelDefinitionInfo, error) {
+	label := &LabelDefinitionInfo{
+		Name:          s.Subsection,
+		Function:      s.Get("function"),
+		Description:   s.Get("description"),
+		Branches:      s.GetAll("branch"),
+		CopyCondition: s.Get("copyCondition"),
+	}
+	if v := s.Get("defaultValue"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("defaultValue: %w", err)
+		}
+		label.DefaultValue = n
+	}
+	for _, line := range s.GetAll("value") {
+		v, text, _ := strings.Cut(strings.TrimSpace(line), " ")
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("value: %w", err)
+		}
+		if label.Values == nil {
+			label.Values = make(map[string]string)
+		}
+		label.Values[formatLabelValue(n)] = strings.TrimSpace(text)
+	}
+
+	var err error
+	if label.CanOverride, err = parseConfigBool(s.Get("canOverride"), true); err != nil {
+		return nil, fmt.Errorf("canOverride: %w", err)
+	}
+	if label.AllowPostSubmit, err = parseConfigBool(s.Get("allowPostSubmit"), true); err != nil {
+		return nil, fmt.Errorf("allowPostSubmit: %w", err)
+	}
+	if label.IgnoreSelfApproval, err = parseConfigBool(s.Get("ignoreSelfApproval"), false); err != nil {
+		return nil, fmt.Errorf("ignoreSelfApproval: %w", err)
+	}
+	return label, nil
+}
+
+// formatLabelValue formats a vote the way the REST API keys label values, e.g. "-1", " 0", "+1".
+func formatLabelValue(v int) string {
+	if v == 0 {
+		return " 0"
+	}
+	return fmt.Sprintf("%+d", v)
+}
+
+func formatLabelValueLine(v int, text string) string {
+	var s string
+	if v > 0 {
+		s = "+" + strconv.Itoa(v)
+	} else {
+		s = strconv.Itoa(v)
+	}
+	if text == "" {
+		return s
+	}
+	return s + " " + text
+}
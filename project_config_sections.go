@@ -0,0 +1,273 @@
+package gerrit
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	accessSection                = "access"
+	exclusiveGroupPermissionsKey = "exclusiveGroupPermissions"
+)
+
+var labelPermissionPrefixes = []string{"label-", "labelAs-", "removeLabel-"}
+
+var permissionRuleRange = regexp.MustCompile(`^([+-]?\d+)\.\.([+-]?\d+)\s+`)
+
+// AccessSections returns the access sections of the config keyed by ref pattern.
+//
+// Rules are keyed by group name as written in project.config.
+// If groups is not nil, names found in it are translated to group UUIDs,
+// matching the keys returned by ProjectsService.ListAccessRights.
+func (c *ProjectConfig) AccessSections(groups *GroupsFile) (map[string]AccessSectionInfo, error) {
+	reply := make(map[string]AccessSectionInfo)
+	for _, s := range c.SectionsNamed(accessSection) {
+		exclusive := make(map[string]bool)
+		for _, p := range strings.Fields(s.Get(exclusiveGroupPermissionsKey)) {
+			exclusive[strings.ToLower(p)] = true
+		}
+
+		section := AccessSectionInfo{Permissions: make(map[string]PermissionInfo)}
+		for _, key := range s.Keys() {
+			if strings.EqualFold(key, exclusiveGroupPermissionsKey) {
+				continue
+			}
+			permission := PermissionInfo{
+				Label:     permissionLabel(key),
+				Exclusive: exclusive[strings.ToLower(key)],
+				Rules:     make(map[string]PermissionRuleInfo),
+			}
+			for _, v := range s.GetAll(key) {
+				group, rule, err := parsePermissionRule(v)
+				if err != nil {
+					return nil, fmt.Errorf("access %q: %s: %w", s.Subsection, key, err)
+				}
+				permission.Rules[groupKey(groups, group)] = rule
+			}
+			section.Permissions[key] = permission
+		}
+		reply[s.Subsection] = section
+	}
+	return reply, nil
+}
+
+// SetAccessSection replaces the permissions of the access section for the ref pattern,
+// creating the section if needed.
+//
+// Existing permissions and rules keep their position in the file; new ones are appended in sorted order.
+// If groups is not nil, rule keys that are group UUIDs found in it are written as group names.
+func (c *ProjectConfig) SetAccessSection(ref string, section AccessSectionInfo, groups *GroupsFile) {
+	s := c.AddSection(accessSection, ref)
+
+	var exclusive []string
+	for _, p := range strings.Fields(s.Get(exclusiveGroupPermissionsKey)) {
+		if info, ok := section.Permissions[p]; ok && info.Exclusive {
+			exclusive = append(exclusive, p)
+		}
+	}
+
+	for _, key := range s.Keys() {
+		if strings.EqualFold(key, exclusiveGroupPermissionsKey) {
+			continue
+		}
+		if _, ok := section.Permissions[key]; !ok {
+			s.Unset(key)
+		}
+	}
+
+	for _, key := range sortedKeys(section.Permissions) {
+		permission := section.Permissions[key]
+		if permission.Exclusive && !containsString(exclusive, key) {
+			exclusive = append(exclusive, key)
+		}
+
+		rules := make(map[string]PermissionRuleInfo, len(permission.Rules))
+		for k, rule := range permission.Rules {
+			rules[groupName(groups, k)] = rule
+		}
+		var values []string
+		for _, v := range s.GetAll(key) {
+			group, _, err := parsePermissionRule(v)
+			if err != nil {
+				continue
+			}
+			if rule, ok := rules[group]; ok {
+				values = append(values, formatPermissionRule(group, rule))
+				delete(rules, group)
+			}
+		}
+		for _, group := range sortedKeys(rules) {
+			values = append(values, formatPermissionRule(group, rules[group]))
+		}
+		s.setAllKeepingFormat(key, values, func(v string) string {
+			group, rule, err := parsePermissionRule(v)
+			if err != nil {
+				return v
+			}
+			return formatPermissionRule(group, rule)
+		})
+	}
+
+	if len(exclusive) == 0 {
+		s.Unset(exclusiveGroupPermissionsKey)
+	} else {
+		s.setString(exclusiveGroupPermissionsKey, strings.Join(exclusive, " "))
+	}
+}
+
+// RemoveAccessSection removes the access section for the ref pattern.
+// It reports whether the section existed.
+func (c *ProjectConfig) RemoveAccessSection(ref string) bool {
+	return c.RemoveSection(accessSection, ref)
+}
+
+func permissionLabel(permission string) string {
+	for _, prefix := range labelPermissionPrefixes {
+		if strings.HasPrefix(permission, prefix) {
+			return permission[len(prefix):]
+		}
+	}
+	return ""
+}
+
+// parsePermissionRule parses a rule like "block +force -2..+2 group Registered Users".
+func parsePermissionRule(s string) (string, PermissionRuleInfo, error) {
+	rule := PermissionRuleInfo{Action: Allow}
+	rest := strings.TrimSpace(s)
+	for _, action := range []PermissionAction{Deny, Block, Interactive, Batch} {
+		prefix := strings.ToLower(string(action)) + " "
+		if strings.HasPrefix(rest, prefix) {
+			rule.Action = action
+			rest = strings.TrimSpace(rest[len(prefix):])
+			break
+		}
+	}
+	if strings.HasPrefix(rest, "+force ") {
+		rule.Force = true
+		rest = strings.TrimSpace(rest[len("+force "):])
+	}
+	if m := permissionRuleRange.FindStringSubmatch(rest); m != nil {
+		rule.Min, _ = strconv.Atoi(m[1])
+		rule.Max, _ = strconv.Atoi(m[2])
+		rest = rest[len(m[0]):]
+	}
+	group, ok := strings.CutPrefix(rest, "group ")
+	group = strings.TrimSpace(group)
+	if !ok || group == "" {
+		return "", rule, fmt.Errorf("invalid permission rule: %q", s)
+	}
+	return group, rule, nil
+}
+
+func formatPermissionRule(group string, rule PermissionRuleInfo) string {
+	var b strings.Builder
+	if rule.Action != "" && rule.Action != Allow {
+		b.WriteString(strings.ToLower(string(rule.Action)))
+		b.WriteByte(' ')
+	}
+	if rule.Force {
+		b.WriteString("+force ")
+	}
+	if rule.Min != 0 || rule.Max != 0 {
+		fmt.Fprintf(&b, "%+d..%+d ", rule.Min, rule.Max)
+	}
+	b.WriteString("group ")
+	b.WriteString(group)
+	return b.String()
+}
+
+func groupKey(groups *GroupsFile, name string) string {
+	if groups != nil {
+		if uuid, ok := groups.UUID(name); ok {
+			return uuid
+		}
+	}
+	return name
+}
+
+func groupName(groups *GroupsFile, key string) string {
+	if groups != nil {
+		if name, ok := groups.Name(key); ok {
+			return name
+		}
+	}
+	return key
+}
+
+// setAllKeepingFormat is like SetAll, but keeps existing values that normalize to a new value.
+func (s *ConfigSection) setAllKeepingFormat(key string, values []string, normalize func(string) string) {
+	existing := s.GetAll(key)
+	used := make([]bool, len(existing))
+	merged := make([]string, len(values))
+	for i, v := range values {
+		merged[i] = v
+		for j, e := range existing {
+			if !used[j] && normalize(e) == v {
+				used[j] = true
+				merged[i] = e
+				break
+			}
+		}
+	}
+	s.SetAll(key, merged)
+}
+
+// setString sets the key, or unsets it if the value is empty.
+func (s *ConfigSection) setString(key, value string) {
+	if value == "" {
+		s.Unset(key)
+		return
+	}
+	s.Set(key, value)
+}
+
+// setInt sets the key, or unsets it if the value is the default.
+// An existing entry holding the same number is left untouched.
+func (s *ConfigSection) setInt(key string, value, def int) {
+	if s.Has(key) {
+		if n, err := strconv.Atoi(s.Get(key)); err == nil && n == value {
+			return
+		}
+	}
+	if value == def {
+		s.Unset(key)
+		return
+	}
+	s.Set(key, strconv.Itoa(value))
+}
+
+// setBool sets the key, or unsets it if the value is the default.
+// An existing entry holding the same boolean is left untouched.
+func (s *ConfigSection) setBool(key string, value, def bool) {
+	if s.Has(key) {
+		if b, err := parseConfigBool(s.Get(key), def); err == nil && b == value {
+			return
+		}
+	}
+	if value == def {
+		s.Unset(key)
+		return
+	}
+	s.Set(key, strconv.FormatBool(value))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package gerrit
+
+import "fmt"
+
+const submitRequirementSection = "submit-requirement"
+
+// SubmitRequirements returns the submit requirements of the config keyed by name.
+func (c *ProjectConfig) SubmitRequirements() (map[string]SubmitRequirementInfo, error) {
+	reply := make(map[string]SubmitRequirementInfo)
+	for _, s := range c.SectionsNamed(submitRequirementSection) {
+		canOverride, err := parseConfigBool(s.Get("canOverrideInChildProjects"), false)
+		if err != nil {
+			return nil, fmt.Errorf("submit-requirement %q: canOverrideInChildProjects: %w", s.Subsection, err)
+		}
+		reply[s.Subsection] = SubmitRequirementInfo{
+			Name:                         s.Subsection,
+			Description:                  s.Get("description"),
+			ApplicabilityExpression:      s.Get("applicableIf"),
+			SubmittabilityExpression:     s.Get("submittableIf"),
+			OverrideExpression:           s.Get("overrideIf"),
+			AllowOverrideInChildProjects: canOverride,
+		}
+	}
+	return reply, nil
+}
+
+// SetSubmitRequirement replaces the submit requirement with the same name, creating its section if needed.
+func (c *ProjectConfig) SetSubmitRequirement(requirement SubmitRequirementInfo) {
+	s := c.AddSection(submitRequirementSection, requirement.Name)
+	s.setString("description", requirement.Description)
+	s.setString("applicableIf", requirement.ApplicabilityExpression)
+	s.setString("submittableIf", requirement.SubmittabilityExpression)
+	s.setString("overrideIf", requirement.OverrideExpression)
+	s.setBool("canOverrideInChildProjects", requirement.AllowOverrideInChildProjects, false)
+}
+
+// RemoveSubmitRequirement removes the submit requirement.
+// It reports whether the submit requirement existed.
+func (c *ProjectConfig) RemoveSubmitRequirement(name string) bool {
+	return c.RemoveSection(submitRequirementSection, name)
+}
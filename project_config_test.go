@@ -0,0 +1,294 @@
+package gerrit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testProjectConfig = `# Managed by the config repo.
+[project]
+	description = Access inherited by all other projects.
+[receive]
+	requireContributorAgreement = false
+	requireSignedOffBy = false  ; inline comment
+	maxObjectSizeLimit = "10 m"
+[access "refs/*"]
+	read = group Administrators
+	read = group Anonymous Users
+[access "refs/heads/*"]
+	exclusiveGroupPermissions = push
+	create = group Administrators
+	push = +force group Administrators
+	# Only owners may vote
+	label-Code-Review = -2..+2 group Project Owners
+	label-Code-Review = block -1..+1 group Registered Users
+
+[label "Code-Review"]
+	function = MaxWithBlock
+	defaultValue = 0
+	value = -2 This shall not be submitted
+	value = -1 I would prefer this is not submitted as is
+	value = 0 No score
+	value = +1 Looks good to me, but someone else must approve
+	value = +2 Looks good to me, approved
+	copyCondition = changekind:NO_CHANGE OR \
+		changekind:TRIVIAL_REBASE
+[submit-requirement "Code-Review"]
+	description = A maximum vote is required
+	submittableIf = label:Code-Review=MAX AND -label:Code-Review=MIN
+	canOverrideInChildProjects = true
+`
+
+const testGroupsFile = `# UUID                                  	Group Name
+#
+3d6da7dc4e99e6f6e5b5196e21b6f504fc530bba	Administrators
+global:Anonymous-Users                  	Anonymous Users
+global:Project-Owners                   	Project Owners
+global:Registered-Users                 	Registered Users
+`
+
+func TestProjectConfigRoundTrip(t *testing.T) {
+	c, err := ParseProjectConfig([]byte(testProjectConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.String(); got != testProjectConfig {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, testProjectConfig)
+	}
+
+	receive := c.Section("receive", "")
+	if got := receive.Get("requireSignedOffBy"); got != "false" {
+		t.Errorf("requireSignedOffBy: got %q", got)
+	}
+	if got := receive.Get("maxobjectsizelimit"); got != "10 m" {
+		t.Errorf("maxObjectSizeLimit: got %q", got)
+	}
+
+	g, err := ParseGroupsFile([]byte(testGroupsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.String(); got != testGroupsFile {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, testGroupsFile)
+	}
+}
+
+func TestProjectConfigEdit(t *testing.T) {
+	c, err := ParseProjectConfig([]byte(testProjectConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Section("receive", "").Set("requireSignedOffBy", "true")
+	c.Section("receive", "").Set("rejectImplicitMerges", "a#b")
+	c.AddSection("plugin", `my "plugin"`).Add("enabled", "true")
+	c.RemoveSection("project", "")
+
+	want := `# Managed by the config repo.
+[receive]
+	requireContributorAgreement = false
+	requireSignedOffBy = true  ; inline comment
+	maxObjectSizeLimit = "10 m"
+	rejectImplicitMerges = "a#b"
+[access "refs/*"]
+`
+	got := c.String()
+	if len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("\ngot:\n%v\nwant prefix:\n%v", got, want)
+	}
+
+	reparsed, err := ParseProjectConfig(c.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reparsed.Section("plugin", `my "plugin"`).Get("enabled"); got != "true" {
+		t.Errorf("plugin enabled: got %q", got)
+	}
+	if got := reparsed.Section("receive", "").Get("rejectImplicitMerges"); got != "a#b" {
+		t.Errorf("rejectImplicitMerges: got %q", got)
+	}
+}
+
+func TestInlineComment(t *testing.T) {
+	tests := []struct {
+		raw, want string
+	}{
+		{"\tkey = value", ""},
+		{"\tkey = value  ; comment", "  ; comment"},
+		{"\tkey = value# comment", "# comment"},
+		{"\tkey = \"a # b\" # comment", " # comment"},
+		{"\tkey = a \\\n\t\tb ; comment", " ; comment"},
+	}
+	for _, tt := range tests {
+		if got := inlineComment(tt.raw); got != tt.want {
+			t.Errorf("inlineComment(%q): got %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestProjectConfigAccessSections(t *testing.T) {
+	c, err := ParseProjectConfig([]byte(testProjectConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := ParseGroupsFile([]byte(testGroupsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sections, err := c.AccessSections(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	heads := sections["refs/heads/*"]
+	want := PermissionInfo{
+		Label: "Code-Review",
+		Rules: map[string]PermissionRuleInfo{
+			"global:Project-Owners":   {Action: Allow, Min: -2, Max: 2},
+			"global:Registered-Users": {Action: Block, Min: -1, Max: 1},
+		},
+	}
+	if got := heads.Permissions["label-Code-Review"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("\ngot:\n%+v\nwant:\n%+v", got, want)
+	}
+	push := heads.Permissions["push"]
+	if !push.Exclusive || !push.Rules["3d6da7dc4e99e6f6e5b5196e21b6f504fc530bba"].Force {
+		t.Errorf("push: got %+v", push)
+	}
+
+	// Writing back the unchanged sections must not modify the file.
+	for ref, section := range sections {
+		c.SetAccessSection(ref, section, g)
+	}
+	if got := c.String(); got != testProjectConfig {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, testProjectConfig)
+	}
+
+	delete(heads.Permissions, "create")
+	push.Exclusive = false
+	heads.Permissions["push"] = push
+	heads.Permissions["submit"] = PermissionInfo{
+		Rules: map[string]PermissionRuleInfo{
+			"global:Project-Owners": {Action: Allow},
+		},
+	}
+	c.SetAccessSection("refs/heads/*", heads, g)
+
+	want2 := `[access "refs/heads/*"]
+	push = +force group Administrators
+	# Only owners may vote
+	label-Code-Review = -2..+2 group Project Owners
+	label-Code-Review = block -1..+1 group Registered Users
+	submit = group Project Owners
+
+`
+	if got := c.String(); !strings.Contains(got, want2) {
+		t.Errorf("\ngot:\n%v\nwant section:\n%v", got, want2)
+	}
+}
+
+func TestProjectConfigLabels(t *testing.T) {
+	c, err := ParseProjectConfig([]byte(testProjectConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labels, err := c.Labels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := labels["Code-Review"]
+	if cr.Function != "MaxWithBlock" || len(cr.Values) != 5 || cr.Values[" 0"] != "No score" ||
+		!cr.CanOverride || !cr.AllowPostSubmit {
+		t.Errorf("label: got %+v", cr)
+	}
+	if cr.CopyCondition != "changekind:NO_CHANGE OR \t\tchangekind:TRIVIAL_REBASE" {
+		t.Errorf("copyCondition: got %q", cr.CopyCondition)
+	}
+
+	c.SetLabel(cr)
+	if got := c.String(); got != testProjectConfig {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, testProjectConfig)
+	}
+
+	c.SetLabel(LabelDefinitionInfo{
+		Name:     "Verified",
+		Function: "MaxWithBlock",
+		Values:   map[string]string{"-1": "Fails", " 0": "No score", "+1": "Verified"},
+		Branches: []string{"refs/heads/main"},
+		// Keep the defaults so that they are not written.
+		CanOverride:     true,
+		AllowPostSubmit: true,
+	})
+
+	want := `[label "Verified"]
+	function = MaxWithBlock
+	value = -1 Fails
+	value = 0 No score
+	value = +1 Verified
+	branch = refs/heads/main
+`
+	if got := c.String(); !strings.HasSuffix(got, want) {
+		t.Errorf("\ngot:\n%v\nwant suffix:\n%v", got, want)
+	}
+}
+
+func TestProjectConfigSubmitRequirements(t *testing.T) {
+	c, err := ParseProjectConfig([]byte(testProjectConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srs, err := c.SubmitRequirements()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr := srs["Code-Review"]
+	if !sr.AllowOverrideInChildProjects || sr.SubmittabilityExpression != "label:Code-Review=MAX AND -label:Code-Review=MIN" {
+		t.Errorf("submit requirement: got %+v", sr)
+	}
+	sr.AllowOverrideInChildProjects = false
+	c.SetSubmitRequirement(sr)
+
+	want := `[submit-requirement "Code-Review"]
+	description = A maximum vote is required
+	submittableIf = label:Code-Review=MAX AND -label:Code-Review=MIN
+`
+	if got := c.String(); !strings.HasSuffix(got, want) {
+		t.Errorf("\ngot:\n%v\nwant suffix:\n%v", got, want)
+	}
+
+	if !c.RemoveSubmitRequirement("Code-Review") || c.RemoveSubmitRequirement("Code-Review") {
+		t.Error("RemoveSubmitRequirement: want true, then false")
+	}
+}
+
+func TestGroupsFile(t *testing.T) {
+	g, err := ParseGroupsFile([]byte(testGroupsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uuid, ok := g.UUID("Registered Users"); !ok || uuid != "global:Registered-Users" {
+		t.Errorf("uuid: got %q", uuid)
+	}
+	g.Set("global:Project-Owners", "Project Owners")
+	g.Set("bbbb", "Developers")
+	g.Remove("global:Anonymous-Users")
+
+	want := `# UUID                                  	Group Name
+#
+3d6da7dc4e99e6f6e5b5196e21b6f504fc530bba	Administrators
+bbbb                                    	Developers
+global:Project-Owners                   	Project Owners
+global:Registered-Users                 	Registered Users
+`
+	if got := g.String(); got != want {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, want)
+	}
+
+	empty, _ := ParseGroupsFile(nil)
+	empty.Set("global:Registered-Users", "Registered Users")
+	if got := len(empty.Groups()); got != 1 {
+		t.Errorf("groups: got %d", got)
+	}
+}
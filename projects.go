@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // ProjectsService
@@ -78,6 +79,25 @@ func (s *ProjectsService) GetProject(ctx context.Context, projectName string) (*
 	return &project, nil
 }
 
+// ListChildProjectsOptions specifies the parameters to the ListChildProjects call.
+type ListChildProjectsOptions struct {
+	// Resolve the child projects of a project recursively.
+	Recursive bool `query:"recursive,omitempty"`
+}
+
+// ListChildProjects lists the direct child projects of a project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-child-projects
+func (s *ProjectsService) ListChildProjects(ctx context.Context, projectName string, opts *ListChildProjectsOptions) ([]*ProjectInfo, error) {
+	u := fmt.Sprintf("projects/%s/children/", projectName)
+
+	var reply []*ProjectInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, opts, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
 // GetHEAD retrieves for a project the name of the branch to which HEAD points.
 //
 // Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-head
@@ -116,6 +136,146 @@ func (s *ProjectsService) GetRepositoryStatistics(ctx context.Context, projectNa
 	return &reply, nil
 }
 
+// GCInput entity contains information to run the Git garbage collection.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#gc-input
+type GCInput struct {
+	// Whether progress information should be shown.
+	ShowProgress bool `json:"show_progress,omitempty"`
+	// Whether an aggressive garbage collection should be done.
+	Aggressive bool `json:"aggressive,omitempty"`
+	// Whether the garbage collection should run asynchronously.
+	Async bool `json:"async,omitempty"`
+}
+
+// GCResult is the outcome of RunGC.
+type GCResult struct {
+	// Log is the text log streamed by a synchronous garbage collection.
+	Log string
+	// TaskID is the ID of the background task of an asynchronous garbage collection.
+	TaskID string
+}
+
+// RunGC runs the Git garbage collection for the repository of a project.
+// A synchronous run returns the log, an asynchronous run returns the ID of the background task.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#run-gc
+func (s *ProjectsService) RunGC(ctx context.Context, projectName string, input *GCInput) (*GCResult, error) {
+	u := fmt.Sprintf("projects/%s/gc", projectName)
+	if input == nil {
+		input = new(GCInput)
+	}
+
+	if input.Async {
+		resp, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, nil)
+		if err != nil {
+			return nil, err
+		}
+		location := resp.Header.Get("Location")
+		_, taskID, ok := strings.Cut(strings.TrimSuffix(location, "/"), "/tasks/")
+		if !ok || taskID == "" || strings.Contains(taskID, "/") {
+			return nil, fmt.Errorf("gc started, but the response has no task location: %q", location)
+		}
+		return &GCResult{TaskID: taskID}, nil
+	}
+
+	var reply string
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &GCResult{Log: reply}, nil
+}
+
+// BanInput entity contains information for banning commits in a project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#ban-input
+type BanInput struct {
+	Commits []string `json:"commits"`
+	Reason  *string  `json:"reason,omitempty"`
+}
+
+// BanResultInfo entity describes the result of banning commits.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#ban-result-info
+type BanResultInfo struct {
+	NewlyBanned   []string `json:"newly_banned,omitempty"`
+	AlreadyBanned []string `json:"already_banned,omitempty"`
+	Ignored       []string `json:"ignored,omitempty"`
+}
+
+// BanCommit marks commits as banned for the project.
+// If a commit is banned Gerrit rejects every push that includes this commit.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#ban-commit
+func (s *ProjectsService) BanCommit(ctx context.Context, projectName string, input *BanInput) (*BanResultInfo, error) {
+	u := fmt.Sprintf("projects/%s/ban", projectName)
+
+	var reply BanResultInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// IndexProjectInput entity contains information for indexing a project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#index-project-input
+type IndexProjectInput struct {
+	// If children should be indexed recursively.
+	IndexChildren bool `json:"index_children,omitempty"`
+}
+
+// IndexProject adds or updates the current project (and children, if specified) in the secondary index.
+// The indexing task is executed asynchronously in background.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#index
+func (s *ProjectsService) IndexProject(ctx context.Context, projectName string, input *IndexProjectInput) error {
+	u := fmt.Sprintf("projects/%s/index", projectName)
+	if input == nil {
+		input = new(IndexProjectInput)
+	}
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IndexChanges adds or updates all the changes belonging to a project in the secondary index.
+// The indexing task is executed asynchronously in background.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#index.changes
+func (s *ProjectsService) IndexChanges(ctx context.Context, projectName string) error {
+	u := fmt.Sprintf("projects/%s/index.changes", projectName)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteProjectInput entity contains the options of the delete-project plugin.
+//
+// Plugin docs: https://gerrit.googlesource.com/plugins/delete-project/+/refs/heads/master/src/main/resources/Documentation/rest-api-projects.md
+type DeleteProjectInput struct {
+	// Delete the project even if it has open changes.
+	Force bool `json:"force,omitempty"`
+	// Keep the Git repository on disk, only remove the project from Gerrit.
+	Preserve bool `json:"preserve,omitempty"`
+}
+
+// DeleteProject deletes a project through the delete-project plugin, which must be installed on the server.
+//
+// Plugin docs: https://gerrit.googlesource.com/plugins/delete-project/+/refs/heads/master/src/main/resources/Documentation/rest-api-projects.md
+func (s *ProjectsService) DeleteProject(ctx context.Context, projectName string, input *DeleteProjectInput) error {
+	u := fmt.Sprintf("projects/%s/delete-project~delete", projectName)
+	if input == nil {
+		input = new(DeleteProjectInput)
+	}
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
 // CreateProjectOptions entity contains information for the creation of a new project.
 type CreateProjectOptions struct {
 	Name                             *string                      `json:"name,omitempty"`
@@ -135,19 +295,54 @@ type CreateProjectOptions struct {
 	MaxObjectSizeLimit               *string                      `json:"max_object_size_limit,omitempty"`
 	PluginConfigValues               map[string]map[string]string `json:"plugin_config_values,omitempty"`
 	RejectEmptyCommit                *string                      `json:"reject_empty_commit,omitempty"`
+
+	// Labels are created in the project right after it has been created.
+	// They are not part of the create-project request, but provisioned through ProvisionLabels.
+	Labels []*LabelDefinitionInput `json:"-"`
+}
+
+// LabelProvisionError is returned by CreateProject if the project was created,
+// but provisioning its labels failed.
+type LabelProvisionError struct {
+	// Project is the created project, it exists on the server without the labels.
+	Project *ProjectInfo
+	Err     error
+}
+
+func (e *LabelProvisionError) Error() string {
+	return fmt.Sprintf("project %s created, but provisioning labels failed: %v", e.Project.Name, e.Err)
+}
+
+func (e *LabelProvisionError) Unwrap() error {
+	return e.Err
 }
 
 // CreateProject creates a new project.
+// If opts.Labels is set, e.g. to StandardLabels(), the labels are provisioned after the project has been created.
+// Servers without the label REST API are rejected with ErrUnsupported before the project is created.
+// When provisioning fails otherwise, the project exists nonetheless and a *LabelProvisionError holding it is returned.
 //
 // Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#create-project
 func (s *ProjectsService) CreateProject(ctx context.Context, projectName string, opts *CreateProjectOptions) (*ProjectInfo, error) {
 	u := fmt.Sprintf("projects/%s/", projectName)
 
+	if opts != nil && len(opts.Labels) > 0 {
+		if err := s.requireLabelAPI(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	var project ProjectInfo
 	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, opts, &project); err != nil {
 		return nil, err
 	}
 
+	if opts != nil && len(opts.Labels) > 0 {
+		if err := s.ProvisionLabels(ctx, projectName, opts.Labels...); err != nil {
+			return nil, &LabelProvisionError{Project: &project, Err: err}
+		}
+	}
+
 	return &project, nil
 }
 
@@ -18,3 +18,83 @@ func (s *ProjectsService) GetCommit(ctx context.Context, projectName, commitID s
 	}
 	return &reply, nil
 }
+
+// IncludedInInfo entity contains information about the branches a change was merged into and tags it was tagged with.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#included-in-info
+type IncludedInInfo struct {
+	Branches []string            `json:"branches"`
+	Tags     []string            `json:"tags"`
+	External map[string][]string `json:"external,omitempty"`
+}
+
+// GetIncludedIn retrieves the branches and tags in which a commit is included.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-included-in
+func (s *ProjectsService) GetIncludedIn(ctx context.Context, projectName, commitID string) (*IncludedInInfo, error) {
+	u := fmt.Sprintf("projects/%s/commits/%s/in", projectName, commitID)
+	var reply IncludedInInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GetCommitContent gets the content of a file from a certain commit.
+// The content is returned as base64 encoded string.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-content-from-commit
+func (s *ProjectsService) GetCommitContent(ctx context.Context, projectName, commitID, fileID string) (string, error) {
+	u := fmt.Sprintf("projects/%s/commits/%s/files/%s/content", projectName, commitID, fileID)
+	var reply string
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return "", err
+	}
+	return reply, nil
+}
+
+// ListCommitFilesOptions specifies the parameters to the ListCommitFiles call.
+type ListCommitFilesOptions struct {
+	// For merge commits, the 1-based number of the parent to compute the list of files against.
+	Parent int `query:"parent,omitempty"`
+}
+
+// ListCommitFiles lists the files that were modified, added or deleted in a commit.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-files
+func (s *ProjectsService) ListCommitFiles(ctx context.Context, projectName, commitID string, opts *ListCommitFilesOptions) (map[string]*FileInfo, error) {
+	u := fmt.Sprintf("projects/%s/commits/%s/files/", projectName, commitID)
+	var reply map[string]*FileInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, opts, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// CherryPickInput entity contains information for cherry-picking a change or a commit to a new branch.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#cherrypick-input
+type CherryPickInput struct {
+	Message        *string `json:"message,omitempty"`
+	Destination    string  `json:"destination"`
+	Base           *string `json:"base,omitempty"`
+	Parent         *int    `json:"parent,omitempty"`
+	Notify         *string `json:"notify,omitempty"`
+	KeepReviewers  *bool   `json:"keep_reviewers,omitempty"`
+	AllowConflicts *bool   `json:"allow_conflicts,omitempty"`
+	Topic          *string `json:"topic,omitempty"`
+	AllowEmpty     *bool   `json:"allow_empty,omitempty"`
+	CommitterEmail *string `json:"committer_email,omitempty"`
+}
+
+// CherryPickCommit cherry-picks a commit of a project to a destination branch, creating a new change.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#cherry-pick-commit
+func (s *ProjectsService) CherryPickCommit(ctx context.Context, projectName, commitID string, input *CherryPickInput) (*ChangeInfo, error) {
+	u := fmt.Sprintf("projects/%s/commits/%s/cherrypick", projectName, commitID)
+	var reply ChangeInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
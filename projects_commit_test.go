@@ -22,3 +22,69 @@ func TestProjectsService_GetCommit(t *testing.T) {
 
 	t.Logf("reply: %+v", reply)
 }
+
+func TestProjectsService_GetIncludedIn(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	commitID := "292acc0fc02e62807b2977120e814ab49cbcd7f0"
+
+	reply, err := client.Projects.GetIncludedIn(context.Background(), "All-Projects", commitID)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("reply: %+v", reply)
+}
+
+func TestProjectsService_GetCommitContent(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	commitID := "292acc0fc02e62807b2977120e814ab49cbcd7f0"
+
+	reply, err := client.Projects.GetCommitContent(context.Background(), "All-Projects", commitID, "project.config")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("content: %v", reply)
+}
+
+func TestProjectsService_ListCommitFiles(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	commitID := "292acc0fc02e62807b2977120e814ab49cbcd7f0"
+
+	reply, err := client.Projects.ListCommitFiles(context.Background(), "All-Projects", commitID, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("files: %v", len(reply))
+}
+
+func TestProjectsService_CherryPickCommit(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	commitID := "292acc0fc02e62807b2977120e814ab49cbcd7f0"
+
+	reply, err := client.Projects.CherryPickCommit(context.Background(), "test-1", commitID, &gerrit.CherryPickInput{
+		Destination: "release-1.0",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("change: %+v", reply)
+}
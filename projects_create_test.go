@@ -0,0 +1,47 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateProjectLabelsUnsupported(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ")]}'\n\"3.5.2\"")
+	}))
+	defer srv.Close()
+	client := NewClient(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+
+	_, err := client.Projects.CreateProject(context.Background(), "app", &CreateProjectOptions{
+		Labels: StandardLabels(),
+	})
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("got %v, want ErrUnsupported", err)
+	}
+	if len(requests) != 1 || requests[0] != "GET /a/config/server/version" {
+		t.Errorf("requests: got %v, want only the version lookup", requests)
+	}
+}
+
+func TestStandardLabels(t *testing.T) {
+	labels := StandardLabels()
+	var names []string
+	for _, l := range labels {
+		names = append(names, *l.Name)
+	}
+	if fmt.Sprint(names) != "[Code-Review Verified QA]" {
+		t.Errorf("names: got %v", names)
+	}
+
+	labels[0].Values["+3"] = "Extra"
+	if _, ok := StandardLabels()[0].Values["+3"]; ok {
+		t.Error("StandardLabels returned shared definitions")
+	}
+}
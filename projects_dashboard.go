@@ -0,0 +1,143 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DashboardSectionInfo entity contains information about a section in a dashboard.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#dashboard-section-info
+type DashboardSectionInfo struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+// DashboardInfo entity contains information about a project dashboard.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#dashboard-info
+type DashboardInfo struct {
+	ID              string                 `json:"id"`
+	Project         string                 `json:"project"`
+	DefiningProject string                 `json:"defining_project"`
+	Ref             string                 `json:"ref"`
+	Path            string                 `json:"path"`
+	Description     string                 `json:"description,omitempty"`
+	Foreach         string                 `json:"foreach,omitempty"`
+	URL             string                 `json:"url"`
+	IsDefault       bool                   `json:"is_default,omitempty"`
+	Title           string                 `json:"title,omitempty"`
+	Sections        []DashboardSectionInfo `json:"sections"`
+}
+
+// DashboardInput entity contains information to create/update a project dashboard.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#dashboard-input
+type DashboardInput struct {
+	ID            *string `json:"id,omitempty"`
+	CommitMessage *string `json:"commit_message,omitempty"`
+}
+
+// DefaultDashboard is the dashboard ID referring to the default dashboard of a project.
+const DefaultDashboard = "default"
+
+// ListDashboards list custom dashboards for a project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-dashboards
+func (s *ProjectsService) ListDashboards(ctx context.Context, projectName string) ([]*DashboardInfo, error) {
+	u := fmt.Sprintf("projects/%s/dashboards/", projectName)
+	var reply []*DashboardInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GetDashboard retrieves a project dashboard.
+// The dashboard ID is the ref and the path of the dashboard, e.g. "main:closed",
+// or DefaultDashboard for the project's default dashboard.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-dashboard
+func (s *ProjectsService) GetDashboard(ctx context.Context, projectName, dashboardID string) (*DashboardInfo, error) {
+	u := fmt.Sprintf("projects/%s/dashboards/%s", projectName, dashboardID)
+	var reply DashboardInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// SetDashboard updates/creates a project dashboard.
+// Currently only supported for the default dashboard, input.ID selects the dashboard to use as default.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#set-dashboard
+func (s *ProjectsService) SetDashboard(ctx context.Context, projectName, dashboardID string, input *DashboardInput) (*DashboardInfo, error) {
+	u := fmt.Sprintf("projects/%s/dashboards/%s", projectName, dashboardID)
+	var reply DashboardInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// DeleteDashboard deletes a project dashboard.
+// Currently only supported for the default dashboard.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#delete-dashboard
+func (s *ProjectsService) DeleteDashboard(ctx context.Context, projectName, dashboardID string) error {
+	u := fmt.Sprintf("projects/%s/dashboards/%s", projectName, dashboardID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DashboardSection is a titled query shown as one section of a Dashboard.
+type DashboardSection struct {
+	Name  string
+	Query Query
+}
+
+// Dashboard builds a custom dashboard URL from titled queries.
+//
+// Gerrit docs: https://gerrit-review.googlesource.com/Documentation/user-dashboards.html#custom-dashboards
+type Dashboard struct {
+	Title string
+	// Foreach is appended to the query of every section.
+	Foreach  Query
+	Sections []DashboardSection
+}
+
+// URL returns the path of the dashboard relative to the Gerrit web UI,
+// e.g. "/dashboard/?title=Mine&foreach=owner%3Aself&Open=status%3Aopen".
+// Sections keep their order and sections with an empty query are left out.
+func (d Dashboard) URL() string {
+	var params []string
+	if d.Title != "" {
+		params = append(params, "title="+dashboardEscape(d.Title))
+	}
+	if d.Foreach != nil {
+		if foreach := d.Foreach.String(); foreach != "" {
+			params = append(params, "foreach="+dashboardEscape(foreach))
+		}
+	}
+	for _, section := range d.Sections {
+		if section.Query == nil {
+			continue
+		}
+		query := section.Query.String()
+		if query == "" {
+			continue
+		}
+		params = append(params, dashboardEscape(section.Name)+"="+dashboardEscape(query))
+	}
+	return "/dashboard/?" + strings.Join(params, "&")
+}
+
+// dashboardEscape percent-encodes a dashboard parameter the way the Gerrit web UI does, spaces as %20.
+func dashboardEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,67 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestProjectsService_ListDashboards(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.ListDashboards(context.Background(), "All-Projects")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("dashboards: %v", len(reply))
+}
+
+func TestProjectsService_GetDashboard(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.GetDashboard(context.Background(), "All-Projects", gerrit.DefaultDashboard)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("dashboard: %+v", reply)
+}
+
+func TestProjectsService_SetDashboard(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.SetDashboard(context.Background(), "test-1", gerrit.DefaultDashboard, &gerrit.DashboardInput{
+		ID: ptr.Ptr("main:closed"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("dashboard: %+v", reply)
+}
+
+func TestProjectsService_DeleteDashboard(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.DeleteDashboard(context.Background(), "test-1", gerrit.DefaultDashboard)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete dashboard ok!")
+}
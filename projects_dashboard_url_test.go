@@ -0,0 +1,23 @@
+package gerrit
+
+import "testing"
+
+func TestDashboard_URL(t *testing.T) {
+	d := Dashboard{
+		Title:   "Team Platform",
+		Foreach: F("project", "platform/app"),
+		Sections: []DashboardSection{
+			{Name: "Needs Review", Query: And(F("status", "open"), Not(F("owner", "self")))},
+			{Name: "Empty", Query: Or()},
+			{Name: "Merged", Query: F("status", "merged")},
+			{Name: "C++", Query: F("label", "Code-Review+2")},
+		},
+	}
+
+	want := "/dashboard/?title=Team%20Platform&foreach=project%3A%22platform%2Fapp%22" +
+		"&Needs%20Review=%28status%3Aopen%20AND%20-owner%3Aself%29&Merged=status%3Amerged" +
+		"&C%2B%2B=label%3A%22Code-Review%2B2%22"
+	if got := d.URL(); got != want {
+		t.Errorf("\ngot:\n%v\nwant:\n%v", got, want)
+	}
+}
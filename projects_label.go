@@ -0,0 +1,217 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+// LabelDefinitionInfo entity describes a label.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#label-definition-info
+type LabelDefinitionInfo struct {
+	Name               string            `json:"name"`
+	ProjectName        string            `json:"project_name,omitempty"`
+	Function           string            `json:"function,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	Values             map[string]string `json:"values,omitempty"`
+	DefaultValue       int               `json:"default_value"`
+	Branches           []string          `json:"branches,omitempty"`
+	CanOverride        bool              `json:"can_override,omitempty"`
+	CopyCondition      string            `json:"copy_condition,omitempty"`
+	AllowPostSubmit    bool              `json:"allow_post_submit,omitempty"`
+	IgnoreSelfApproval bool              `json:"ignore_self_approval,omitempty"`
+}
+
+// LabelDefinitionInput entity describes a label definition to be created or updated.
+// Fields that are not set are not modified on update.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#label-definition-input
+type LabelDefinitionInput struct {
+	Name               *string           `json:"name,omitempty"`
+	CommitMessage      *string           `json:"commit_message,omitempty"`
+	Function           *string           `json:"function,omitempty"`
+	Description        *string           `json:"description,omitempty"`
+	Values             map[string]string `json:"values,omitempty"`
+	DefaultValue       *int              `json:"default_value,omitempty"`
+	Branches           []string          `json:"branches,omitempty"`
+	CanOverride        *bool             `json:"can_override,omitempty"`
+	CopyCondition      *string           `json:"copy_condition,omitempty"`
+	UnsetCopyCondition *bool             `json:"unset_copy_condition,omitempty"`
+	AllowPostSubmit    *bool             `json:"allow_post_submit,omitempty"`
+	IgnoreSelfApproval *bool             `json:"ignore_self_approval,omitempty"`
+}
+
+// DeleteLabelInput entity contains information for deleting a label definition in a project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#delete-label-input
+type DeleteLabelInput struct {
+	CommitMessage *string `json:"commit_message,omitempty"`
+}
+
+// BatchLabelInput entity contains information for batch updating label definitions in a project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#batch-label-input
+type BatchLabelInput struct {
+	CommitMessage *string                          `json:"commit_message,omitempty"`
+	Delete        []string                         `json:"delete,omitempty"`
+	Create        []*LabelDefinitionInput          `json:"create,omitempty"`
+	Update        map[string]*LabelDefinitionInput `json:"update,omitempty"`
+}
+
+// ListLabels lists the labels that are defined in this project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-labels
+func (s *ProjectsService) ListLabels(ctx context.Context, projectName string) ([]*LabelDefinitionInfo, error) {
+	if err := s.requireLabelAPI(ctx); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/labels/", projectName)
+	var reply []*LabelDefinitionInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GetLabel retrieves the definition of a label that is defined in this project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-label
+func (s *ProjectsService) GetLabel(ctx context.Context, projectName, labelName string) (*LabelDefinitionInfo, error) {
+	if err := s.requireLabelAPI(ctx); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/labels/%s", projectName, labelName)
+	var reply LabelDefinitionInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// CreateLabel creates a new label definition in this project.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#create-label
+func (s *ProjectsService) CreateLabel(ctx context.Context, projectName, labelName string, input *LabelDefinitionInput) (*LabelDefinitionInfo, error) {
+	return s.putLabel(ctx, projectName, labelName, input)
+}
+
+// UpdateLabel updates the definition of a label that is defined in this project.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#set-label
+func (s *ProjectsService) UpdateLabel(ctx context.Context, projectName, labelName string, input *LabelDefinitionInput) (*LabelDefinitionInfo, error) {
+	return s.putLabel(ctx, projectName, labelName, input)
+}
+
+func (s *ProjectsService) putLabel(ctx context.Context, projectName, labelName string, input *LabelDefinitionInput) (*LabelDefinitionInfo, error) {
+	if err := s.requireLabelAPI(ctx); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/labels/%s", projectName, labelName)
+	if input == nil {
+		input = new(LabelDefinitionInput)
+	}
+	var reply LabelDefinitionInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// DeleteLabel deletes the definition of a label that is defined in this project.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#delete-label
+func (s *ProjectsService) DeleteLabel(ctx context.Context, projectName, labelName string, input ...*DeleteLabelInput) error {
+	if err := s.requireLabelAPI(ctx); err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("projects/%s/labels/%s", projectName, labelName)
+	if len(input) > 0 && input[0] != nil {
+		_, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, input[0], nil)
+		return err
+	}
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BatchUpdateLabels creates, updates and deletes label definitions of this project in a single commit.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#batch-update-labels
+func (s *ProjectsService) BatchUpdateLabels(ctx context.Context, projectName string, input *BatchLabelInput) error {
+	if err := s.requireLabelAPI(ctx); err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("projects/%s/labels/", projectName)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, u, input, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ProvisionLabels creates the given label definitions in a project in a single commit.
+func (s *ProjectsService) ProvisionLabels(ctx context.Context, projectName string, labels ...*LabelDefinitionInput) error {
+	return s.BatchUpdateLabels(ctx, projectName, &BatchLabelInput{
+		CommitMessage: ptr.Ptr("Provision labels"),
+		Create:        labels,
+	})
+}
+
+// StandardLabels returns the definitions of the Code-Review, Verified and QA labels
+// provisioned for new projects, to be used as CreateProjectOptions.Labels.
+// A new slice is returned on every call, so callers may adjust the definitions.
+func StandardLabels() []*LabelDefinitionInput {
+	return []*LabelDefinitionInput{
+		{
+			Name:     ptr.Ptr("Code-Review"),
+			Function: ptr.Ptr("MaxWithBlock"),
+			Values: map[string]string{
+				"-2": "This shall not be submitted",
+				"-1": "I would prefer this is not submitted as is",
+				" 0": "No score",
+				"+1": "Looks good to me, but someone else must approve",
+				"+2": "Looks good to me, approved",
+			},
+			DefaultValue:  ptr.Ptr(0),
+			CopyCondition: ptr.Ptr("changekind:NO_CHANGE OR changekind:TRIVIAL_REBASE OR is:MIN"),
+		},
+		{
+			Name:     ptr.Ptr("Verified"),
+			Function: ptr.Ptr("MaxWithBlock"),
+			Values: map[string]string{
+				"-1": "Fails",
+				" 0": "No score",
+				"+1": "Verified",
+			},
+			DefaultValue:  ptr.Ptr(0),
+			CopyCondition: ptr.Ptr("changekind:NO_CODE_CHANGE"),
+		},
+		{
+			Name:     ptr.Ptr("QA"),
+			Function: ptr.Ptr("MaxWithBlock"),
+			Values: map[string]string{
+				"-1": "QA failed",
+				" 0": "No score",
+				"+1": "QA passed",
+			},
+			DefaultValue: ptr.Ptr(0),
+		},
+	}
+}
+
+// requireLabelAPI checks that the server has the label REST endpoints, which were added in Gerrit 3.6.
+func (s *ProjectsService) requireLabelAPI(ctx context.Context) error {
+	return s.client.requireVersion(ctx, "label REST API", 3, 6)
+}
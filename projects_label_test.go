@@ -0,0 +1,134 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestProjectsService_ListLabels(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.ListLabels(context.Background(), "All-Projects")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("labels: %v", len(reply))
+}
+
+func TestProjectsService_GetLabel(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.GetLabel(context.Background(), "All-Projects", "Code-Review")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("label: %+v", reply)
+}
+
+func TestProjectsService_CreateLabel(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.CreateLabel(context.Background(), "test-1", "Verified", &gerrit.LabelDefinitionInput{
+		Function: ptr.Ptr("MaxWithBlock"),
+		Values: map[string]string{
+			"-1": "Fails",
+			" 0": "No score",
+			"+1": "Verified",
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("label: %+v", reply)
+}
+
+func TestProjectsService_UpdateLabel(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.UpdateLabel(context.Background(), "test-1", "Verified", &gerrit.LabelDefinitionInput{
+		Description: ptr.Ptr("Set by CI"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("label: %+v", reply)
+}
+
+func TestProjectsService_DeleteLabel(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.DeleteLabel(context.Background(), "test-1", "Verified")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete label ok!")
+}
+
+func TestProjectsService_BatchUpdateLabels(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.BatchUpdateLabels(context.Background(), "test-1", &gerrit.BatchLabelInput{
+		Create: []*gerrit.LabelDefinitionInput{
+			{
+				Name: ptr.Ptr("QA"),
+				Values: map[string]string{
+					"-1": "Fails",
+					" 0": "No score",
+					"+1": "Passes",
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("batch update labels ok!")
+}
+
+func TestProjectsService_ProvisionLabels(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.ProvisionLabels(context.Background(), "test-1", &gerrit.LabelDefinitionInput{
+		Name: ptr.Ptr("Verified"),
+		Values: map[string]string{
+			"-1": "Fails",
+			" 0": "No score",
+			"+1": "Verified",
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("provision labels ok!")
+}
@@ -0,0 +1,122 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SubmitRequirementInfo entity describes a submit requirement.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#submit-requirement-info
+type SubmitRequirementInfo struct {
+	Name                         string `json:"name"`
+	Description                  string `json:"description,omitempty"`
+	ApplicabilityExpression      string `json:"applicability_expression,omitempty"`
+	SubmittabilityExpression     string `json:"submittability_expression"`
+	OverrideExpression           string `json:"override_expression,omitempty"`
+	AllowOverrideInChildProjects bool   `json:"allow_override_in_child_projects"`
+}
+
+// SubmitRequirementInput entity describes a submit requirement to be created or updated.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#submit-requirement-input
+type SubmitRequirementInput struct {
+	Name                         *string `json:"name,omitempty"`
+	Description                  *string `json:"description,omitempty"`
+	ApplicabilityExpression      *string `json:"applicability_expression,omitempty"`
+	SubmittabilityExpression     *string `json:"submittability_expression,omitempty"`
+	OverrideExpression           *string `json:"override_expression,omitempty"`
+	AllowOverrideInChildProjects *bool   `json:"allow_override_in_child_projects,omitempty"`
+}
+
+// ListSubmitRequirementsOptions specifies the parameters to the ListSubmitRequirements call.
+type ListSubmitRequirementsOptions struct {
+	// Include the submit requirements inherited from parent projects.
+	Inherited bool `query:"inherited,omitempty"`
+}
+
+// ListSubmitRequirements lists the submit requirements that are defined in this project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-submit-requirements
+func (s *ProjectsService) ListSubmitRequirements(ctx context.Context, projectName string, opts *ListSubmitRequirementsOptions) ([]*SubmitRequirementInfo, error) {
+	if err := s.requireSubmitRequirements(ctx); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/submit_requirements", projectName)
+	var reply []*SubmitRequirementInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, opts, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GetSubmitRequirement retrieves the definition of a submit requirement that is defined in this project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-submit-requirement
+func (s *ProjectsService) GetSubmitRequirement(ctx context.Context, projectName, name string) (*SubmitRequirementInfo, error) {
+	if err := s.requireSubmitRequirements(ctx); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/submit_requirements/%s", projectName, name)
+	var reply SubmitRequirementInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, u, nil, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// CreateSubmitRequirement creates a new submit requirement definition in this project.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#create-submit-requirement
+func (s *ProjectsService) CreateSubmitRequirement(ctx context.Context, projectName, name string, input *SubmitRequirementInput) (*SubmitRequirementInfo, error) {
+	return s.putSubmitRequirement(ctx, projectName, name, input)
+}
+
+// UpdateSubmitRequirement updates the definition of a submit requirement that is defined in this project.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#update-submit-requirement
+func (s *ProjectsService) UpdateSubmitRequirement(ctx context.Context, projectName, name string, input *SubmitRequirementInput) (*SubmitRequirementInfo, error) {
+	return s.putSubmitRequirement(ctx, projectName, name, input)
+}
+
+func (s *ProjectsService) putSubmitRequirement(ctx context.Context, projectName, name string, input *SubmitRequirementInput) (*SubmitRequirementInfo, error) {
+	if err := s.requireSubmitRequirements(ctx); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/submit_requirements/%s", projectName, name)
+	if input == nil {
+		input = new(SubmitRequirementInput)
+	}
+	var reply SubmitRequirementInfo
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, u, input, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// DeleteSubmitRequirement deletes the definition of a submit requirement that is defined in this project.
+// The calling user must have write access to the refs/meta/config branch of the project.
+//
+// Gerrit API docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#delete-submit-requirement
+func (s *ProjectsService) DeleteSubmitRequirement(ctx context.Context, projectName, name string) error {
+	if err := s.requireSubmitRequirements(ctx); err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("projects/%s/submit_requirements/%s", projectName, name)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, u, nil, nil, DelContentType()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// requireSubmitRequirements checks that the server supports submit requirements, which were added in Gerrit 3.5.
+func (s *ProjectsService) requireSubmitRequirements(ctx context.Context) error {
+	return s.client.requireVersion(ctx, "submit requirements", 3, 5)
+}
@@ -0,0 +1,85 @@
+package gerrit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gerrit"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestProjectsService_ListSubmitRequirements(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.ListSubmitRequirements(context.Background(), "All-Projects", nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("submit requirements: %v", len(reply))
+}
+
+func TestProjectsService_GetSubmitRequirement(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.GetSubmitRequirement(context.Background(), "All-Projects", "Code-Review")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("submit requirement: %+v", reply)
+}
+
+func TestProjectsService_CreateSubmitRequirement(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.CreateSubmitRequirement(context.Background(), "test-1", "Verified", &gerrit.SubmitRequirementInput{
+		Description:              ptr.Ptr("CI must pass"),
+		SubmittabilityExpression: ptr.Ptr("label:Verified=MAX AND -label:Verified=MIN"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("submit requirement: %+v", reply)
+}
+
+func TestProjectsService_UpdateSubmitRequirement(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.UpdateSubmitRequirement(context.Background(), "test-1", "Verified", &gerrit.SubmitRequirementInput{
+		SubmittabilityExpression:     ptr.Ptr("label:Verified=MAX"),
+		AllowOverrideInChildProjects: ptr.Ptr(true),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("submit requirement: %+v", reply)
+}
+
+func TestProjectsService_DeleteSubmitRequirement(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.DeleteSubmitRequirement(context.Background(), "test-1", "Verified")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete submit requirement ok!")
+}
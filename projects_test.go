@@ -3,6 +3,7 @@ package gerrit_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/nexuer/go-gerrit"
@@ -96,3 +97,117 @@ func TestProjectsService_ListAccessRights(t *testing.T) {
 
 	t.Logf("reply: %v", reply)
 }
+
+func TestProjectsService_RunGC(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.RunGC(context.Background(), "test-1", &gerrit.GCInput{
+		ShowProgress: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("gc log: %v", reply.Log)
+}
+
+func TestProjectsService_BanCommit(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.BanCommit(context.Background(), "test-1", &gerrit.BanInput{
+		Commits: []string{"292acc0fc02e62807b2977120e814ab49cbcd7f0"},
+		Reason:  ptr.Ptr("contains secrets"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("reply: %+v", reply)
+}
+
+func TestProjectsService_IndexProject(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.IndexProject(context.Background(), "All-Projects", &gerrit.IndexProjectInput{
+		IndexChildren: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("index project ok!")
+}
+
+func TestProjectsService_IndexChanges(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.IndexChanges(context.Background(), "test-1")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("index changes ok!")
+}
+
+func TestProjectsService_DeleteProject(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	err := client.Projects.DeleteProject(context.Background(), "test-1", &gerrit.DeleteProjectInput{
+		Force: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("delete project ok!")
+}
+
+func TestProjectsService_ListChildProjects(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	reply, err := client.Projects.ListChildProjects(context.Background(), "All-Projects", &gerrit.ListChildProjectsOptions{
+		Recursive: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("children: %v", len(reply))
+}
+
+func TestProjectsService_GetProjectTree(t *testing.T) {
+	client := gerrit.NewClient(testPasswordCredential, &gerrit.Options{
+		Debug: true,
+	})
+
+	tree, err := client.Projects.GetProjectTree(context.Background(), &gerrit.ListProjectsOptions{
+		All: ptr.Ptr(true),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = tree.Walk(func(node *gerrit.ProjectNode, depth int) error {
+		fmt.Println(strings.Repeat("  ", depth) + node.Name)
+		return nil
+	})
+}
@@ -0,0 +1,207 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// AllProjects is the default name of the root project all other projects inherit from.
+const AllProjects = "All-Projects"
+
+// SkipChildren is used as a return value from a ProjectTree.Walk function
+// to indicate that the children of the current project are to be skipped.
+var SkipChildren = errors.New("skip children")
+
+// ProjectNode is a project in a ProjectTree.
+type ProjectNode struct {
+	Name string
+	// Project is nil for parents that are referenced by a project but not visible to the caller.
+	Project  *ProjectInfo
+	Parent   *ProjectNode
+	Children []*ProjectNode
+}
+
+// ProjectTree is the in-memory inheritance hierarchy of projects, rooted at All-Projects.
+type ProjectTree struct {
+	Root *ProjectNode
+
+	nodes map[string]*ProjectNode
+}
+
+// NewProjectTree builds the inheritance hierarchy of projects as returned by ListProjects with the tree option.
+//
+// The root is the project without a parent, preferring All-Projects if there are several.
+// Parents that are not part of projects are added as nodes without a ProjectInfo,
+// projects whose chain of parents does not reach the root are attached to the root.
+// Children are sorted by name.
+func NewProjectTree(projects map[string]*ProjectInfo) *ProjectTree {
+	t := &ProjectTree{nodes: make(map[string]*ProjectNode, len(projects))}
+	for name, p := range projects {
+		if p != nil && p.Name != "" {
+			name = p.Name
+		}
+		t.node(name).Project = p
+	}
+
+	rootName := AllProjects
+	if _, ok := t.nodes[rootName]; !ok {
+		for _, name := range sortedKeys(t.nodes) {
+			if p := t.nodes[name].Project; p != nil && p.Parent == "" {
+				rootName = name
+				break
+			}
+		}
+	}
+	t.Root = t.node(rootName)
+
+	for _, name := range sortedKeys(t.nodes) {
+		n := t.nodes[name]
+		if n == t.Root {
+			continue
+		}
+		parent := t.Root
+		if n.Project != nil && n.Project.Parent != "" {
+			parent = t.node(n.Project.Parent)
+		}
+		n.Parent = parent
+	}
+
+	// Parents that were only referenced hang off the root, and so do projects caught in a cycle.
+	for _, n := range t.nodes {
+		if n != t.Root && n.Parent == nil {
+			n.Parent = t.Root
+		}
+	}
+	for _, name := range sortedKeys(t.nodes) {
+		if n := t.nodes[name]; n != t.Root && t.hasCycle(n) {
+			n.Parent = t.Root
+		}
+	}
+
+	for _, name := range sortedKeys(t.nodes) {
+		n := t.nodes[name]
+		if n.Parent != nil {
+			n.Parent.Children = append(n.Parent.Children, n)
+		}
+	}
+	for _, n := range t.nodes {
+		sort.Slice(n.Children, func(i, j int) bool {
+			return n.Children[i].Name < n.Children[j].Name
+		})
+	}
+	return t
+}
+
+// GetProjectTree lists all projects with their parents and builds their inheritance hierarchy.
+// The tree option is always set, the other options are passed to ListProjects.
+func (s *ProjectsService) GetProjectTree(ctx context.Context, opts *ListProjectsOptions) (*ProjectTree, error) {
+	args := new(ListProjectsOptions)
+	if opts != nil {
+		*args = *opts
+	}
+	tree := true
+	args.Tree = &tree
+
+	projects, err := s.ListProjects(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return NewProjectTree(projects), nil
+}
+
+// Node returns the node of a project, or nil if the project is not part of the tree.
+func (t *ProjectTree) Node(name string) *ProjectNode {
+	return t.nodes[name]
+}
+
+// Ancestors returns the parents of a project, nearest first and ending with the root.
+func (t *ProjectTree) Ancestors(name string) []*ProjectNode {
+	n := t.nodes[name]
+	if n == nil {
+		return nil
+	}
+	var reply []*ProjectNode
+	for p := n.Parent; p != nil; p = p.Parent {
+		reply = append(reply, p)
+	}
+	return reply
+}
+
+// Descendants returns all projects inheriting from a project in depth-first order, excluding the project itself.
+func (t *ProjectTree) Descendants(name string) []*ProjectNode {
+	n := t.nodes[name]
+	if n == nil {
+		return nil
+	}
+	var reply []*ProjectNode
+	_ = n.walk(0, func(node *ProjectNode, depth int) error {
+		if node != n {
+			reply = append(reply, node)
+		}
+		return nil
+	})
+	return reply
+}
+
+// IsAncestor reports whether project ancestor is a direct or indirect parent of project name.
+func (t *ProjectTree) IsAncestor(ancestor, name string) bool {
+	for _, p := range t.Ancestors(name) {
+		if p.Name == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk visits every project of the tree in depth-first order, starting at the root.
+// depth is 0 for the root. If fn returns SkipChildren, the children of the project are skipped;
+// any other error stops the walk and is returned.
+func (t *ProjectTree) Walk(fn func(node *ProjectNode, depth int) error) error {
+	return t.WalkFrom(t.Root.Name, fn)
+}
+
+// WalkFrom is like Walk, but starts at the given project.
+// It does nothing if the project is not part of the tree.
+func (t *ProjectTree) WalkFrom(name string, fn func(node *ProjectNode, depth int) error) error {
+	n := t.nodes[name]
+	if n == nil {
+		return nil
+	}
+	return n.walk(0, fn)
+}
+
+func (n *ProjectNode) walk(depth int, fn func(node *ProjectNode, depth int) error) error {
+	if err := fn(n, depth); err != nil {
+		if errors.Is(err, SkipChildren) {
+			return nil
+		}
+		return err
+	}
+	for _, c := range n.Children {
+		if err := c.walk(depth+1, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *ProjectTree) node(name string) *ProjectNode {
+	n, ok := t.nodes[name]
+	if !ok {
+		n = &ProjectNode{Name: name}
+		t.nodes[name] = n
+	}
+	return n
+}
+
+func (t *ProjectTree) hasCycle(n *ProjectNode) bool {
+	seen := make(map[*ProjectNode]bool)
+	for p := n; p != nil; p = p.Parent {
+		if seen[p] {
+			return true
+		}
+		seen[p] = true
+	}
+	return false
+}
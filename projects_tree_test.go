@@ -0,0 +1,53 @@
+package gerrit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProjectTree(t *testing.T) {
+	tree := NewProjectTree(map[string]*ProjectInfo{
+		"All-Projects":  {ID: "All-Projects"},
+		"All-Users":     {ID: "All-Users", Parent: "All-Projects"},
+		"platform":      {ID: "platform", Parent: "All-Projects"},
+		"platform/base": {ID: "platform%2Fbase", Parent: "platform"},
+		"platform/app":  {ID: "platform%2Fapp", Parent: "platform"},
+		"tools/ci":      {ID: "tools%2Fci", Parent: "tools"},
+	})
+
+	names := func(nodes []*ProjectNode) []string {
+		var reply []string
+		for _, n := range nodes {
+			reply = append(reply, n.Name)
+		}
+		return reply
+	}
+
+	if got, want := names(tree.Ancestors("platform/app")), []string{"platform", "All-Projects"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ancestors: got %v, want %v", got, want)
+	}
+	if got, want := names(tree.Descendants("All-Projects")), []string{"All-Users", "platform", "platform/app", "platform/base", "tools", "tools/ci"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("descendants: got %v, want %v", got, want)
+	}
+	if tools := tree.Node("tools"); tools == nil || tools.Project != nil || tools.Parent != tree.Root {
+		t.Errorf("tools: got %+v", tools)
+	}
+	if !tree.IsAncestor("platform", "platform/base") || tree.IsAncestor("platform/base", "platform") {
+		t.Errorf("IsAncestor: unexpected result")
+	}
+
+	var walked []string
+	err := tree.Walk(func(node *ProjectNode, depth int) error {
+		walked = append(walked, node.Name)
+		if node.Name == "platform" {
+			return SkipChildren
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"All-Projects", "All-Users", "platform", "tools", "tools/ci"}; !reflect.DeepEqual(walked, want) {
+		t.Errorf("walk: got %v, want %v", walked, want)
+	}
+}
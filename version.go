@@ -0,0 +1,182 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupported is returned by methods that need a newer Gerrit version than the server runs.
+var ErrUnsupported = errors.New("unsupported by the gerrit server")
+
+// Version is a parsed Gerrit version, like 3.9.1 or 3.9.1-12-gabcdef.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	// PreRelease is the pre-release of a version like 3.10.0-rc2, e.g. "rc2".
+	PreRelease string
+	// Commits is the number of commits on top of the release for versions like 3.9.1-12-gabcdef.
+	Commits int
+	// Raw is the version as parsed.
+	Raw string
+}
+
+// ParseVersion parses a version as returned by GetVersion.
+// A leading "v" and a missing patch number are accepted.
+func ParseVersion(s string) (Version, error) {
+	v := Version{Raw: s}
+	rest := strings.TrimPrefix(strings.TrimSpace(s), "v")
+
+	core, suffix, _ := strings.Cut(rest, "-")
+	parts := strings.Split(core, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid gerrit version %q", s)
+	}
+	nums := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid gerrit version %q", s)
+		}
+		nums[i] = n
+	}
+	v.Major, v.Minor, v.Patch = nums[0], nums[1], nums[2]
+
+	// The suffix is [pre-release][-commits-gsha], as produced by git describe.
+	fields := strings.Split(suffix, "-")
+	if n := len(fields); n >= 2 && strings.HasPrefix(fields[n-1], "g") {
+		if commits, err := strconv.Atoi(fields[n-2]); err == nil {
+			v.Commits = commits
+			fields = fields[:n-2]
+		}
+	}
+	v.PreRelease = strings.Join(fields, "-")
+	return v, nil
+}
+
+// String returns the version as parsed, or in major.minor.patch form if it was not parsed.
+func (v Version) String() string {
+	if v.Raw != "" {
+		return v.Raw
+	}
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is older than, the same as or newer than o.
+// Pre-releases are older than their release, and commits on top of a version are newer than it.
+func (v Version) Compare(o Version) int {
+	for _, d := range [][2]int{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if d[0] != d[1] {
+			return cmpInt(d[0], d[1])
+		}
+	}
+	switch {
+	case v.PreRelease == o.PreRelease:
+	case v.PreRelease == "":
+		return 1
+	case o.PreRelease == "":
+		return -1
+	default:
+		return strings.Compare(v.PreRelease, o.PreRelease)
+	}
+	return cmpInt(v.Commits, o.Commits)
+}
+
+// AtLeast reports whether v is major.minor or newer, counting pre-releases of major.minor.0 as major.minor.
+func (v Version) AtLeast(major, minor int) bool {
+	return v.Major > major || (v.Major == major && v.Minor >= minor)
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+const (
+	// versionErrorTTL is how long a failure to get the server version is cached.
+	versionErrorTTL = time.Minute
+	// versionFetchTimeout bounds a lookup of the server version, which is not cancelled with its caller.
+	versionFetchTimeout = 30 * time.Second
+)
+
+// versionCall is a lookup of the server version, shared by concurrent callers and cached by the client.
+type versionCall struct {
+	done    chan struct{}
+	version Version
+	err     error
+	// expires is when a failed lookup is retried.
+	expires time.Time
+}
+
+// GetServerVersion returns the parsed version of the Gerrit server.
+// The version is cached by the client after the first successful call,
+// a failure is cached for a minute so that gated calls do not request the version each time.
+// Cancelling ctx returns early, but does not abort a lookup other callers are waiting for.
+func (s *ConfigService) GetServerVersion(ctx context.Context) (Version, error) {
+	c := s.client
+	c.versionMu.Lock()
+	call := c.version
+	if call != nil {
+		select {
+		case <-call.done:
+			if call.err != nil && time.Now().After(call.expires) {
+				call = nil
+			}
+		default:
+		}
+	}
+	if call == nil {
+		call = &versionCall{done: make(chan struct{})}
+		c.version = call
+		c.versionMu.Unlock()
+
+		// The lookup is shared, so it must not fail because the caller that started it gave up.
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), versionFetchTimeout)
+		go func() {
+			defer cancel()
+			call.version, call.err = s.fetchServerVersion(fetchCtx)
+			call.expires = time.Now().Add(versionErrorTTL)
+			close(call.done)
+		}()
+	} else {
+		c.versionMu.Unlock()
+	}
+
+	select {
+	case <-call.done:
+		return call.version, call.err
+	case <-ctx.Done():
+		return Version{}, ctx.Err()
+	}
+}
+
+func (s *ConfigService) fetchServerVersion(ctx context.Context) (Version, error) {
+	raw, err := s.GetVersion(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+	return ParseVersion(raw)
+}
+
+// requireVersion returns an error wrapping ErrUnsupported if the server is older than major.minor.
+// If the version of the server cannot be determined, the call is let through.
+func (c *Client) requireVersion(ctx context.Context, feature string, major, minor int) error {
+	v, err := c.Config.GetServerVersion(ctx)
+	if err != nil || v.AtLeast(major, minor) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s requires Gerrit %d.%d or later, server runs %s", ErrUnsupported, feature, major, minor, v)
+}
@@ -0,0 +1,167 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Version
+	}{
+		{
+			input: "3.9.1",
+			want:  Version{Major: 3, Minor: 9, Patch: 1},
+		},
+		{
+			input: "3.9.1-12-gabcdef",
+			want:  Version{Major: 3, Minor: 9, Patch: 1, Commits: 12},
+		},
+		{
+			input: "v3.10.0-rc2-5-g0123456",
+			want:  Version{Major: 3, Minor: 10, PreRelease: "rc2", Commits: 5},
+		},
+		{
+			input: "2.16",
+			want:  Version{Major: 2, Minor: 16},
+		},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.input)
+		if err != nil {
+			t.Errorf("%s: %v", tt.input, err)
+			continue
+		}
+		tt.want.Raw = tt.input
+		if got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "3", "3.x.1", "unknown"} {
+		if _, err := ParseVersion(input); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	ordered := []string{"2.16.28", "3.5.0-rc1", "3.5.0", "3.5.0-3-gabcdef", "3.5.1", "3.10.0"}
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := ParseVersion(ordered[i])
+			b, _ := ParseVersion(ordered[j])
+			if got, want := a.Compare(b), cmpInt(i, j); got != want {
+				t.Errorf("%s vs %s: got %d, want %d", a, b, got, want)
+			}
+		}
+	}
+
+	v, _ := ParseVersion("3.5.0-rc1")
+	if !v.AtLeast(3, 5) || v.AtLeast(3, 6) {
+		t.Errorf("%s: AtLeast is wrong", v)
+	}
+}
+
+func TestGetServerVersionCache(t *testing.T) {
+	var requests atomic.Int32
+	fail := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if fail {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ")]}'\n\"3.9.1\"")
+	}))
+	defer srv.Close()
+	client := NewClient(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Config.GetServerVersion(ctx); err == nil {
+			t.Fatal("want error")
+		}
+		if err := client.requireVersion(ctx, "test", 3, 5); err != nil {
+			t.Fatalf("requireVersion: got %v, want the call let through", err)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("failed lookups: got %d requests, want 1", n)
+	}
+
+	// A new credential resets the cache.
+	fail = false
+	client.SetCredential(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := client.Config.GetServerVersion(ctx); err != nil || v.String() != "3.9.1" {
+				t.Errorf("got %v, %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := requests.Load(); n != 2 {
+		t.Errorf("concurrent lookups: got %d requests, want 2", n)
+	}
+}
+
+func TestGetServerVersionCancel(t *testing.T) {
+	var requests atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ")]}'\n\"3.9.1\"")
+	}))
+	defer srv.Close()
+	client := NewClient(&PasswordCredential{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+
+	// Caller A starts the lookup and gives up while it is in flight.
+	ctxA, cancelA := context.WithCancel(context.Background())
+	errA := make(chan error, 1)
+	go func() {
+		_, err := client.Config.GetServerVersion(ctxA)
+		errA <- err
+	}()
+	<-started
+
+	// Caller B waits for the same lookup.
+	type result struct {
+		v   Version
+		err error
+	}
+	resB := make(chan result, 1)
+	go func() {
+		v, err := client.Config.GetServerVersion(context.Background())
+		resB <- result{v, err}
+	}()
+
+	cancelA()
+	if err := <-errA; err != context.Canceled {
+		t.Errorf("caller A: got %v, want %v", err, context.Canceled)
+	}
+	close(release)
+
+	res := <-resB
+	if res.err != nil || res.v.String() != "3.9.1" {
+		t.Errorf("caller B: got %v, %v", res.v, res.err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}